@@ -48,14 +48,59 @@ type Matcher struct {
 	Value   string `json:"value"`
 }
 
+// ConditionMigrated is the condition type reporting the migration of a Silence to
+// observability.giantswarm.io/v1alpha2. It is only set on Silences selected for migration.
+const ConditionMigrated = "Migrated"
+
+// Condition reasons reported for ConditionMigrated.
+const (
+	// ReasonMigrationInProgress is used while the v1alpha2 Silence waits to be synced with Alertmanager.
+	ReasonMigrationInProgress = "InProgress"
+	// ReasonMigrationTargetSyncFailed is used when the v1alpha2 Silence failed to sync with Alertmanager.
+	ReasonMigrationTargetSyncFailed = "TargetSyncFailed"
+	// ReasonMigrationTargetConflict is used when a v1alpha2 Silence with the same name exists
+	// and was not created by the migration.
+	ReasonMigrationTargetConflict = "TargetConflict"
+	// ReasonMigrationInvalidSource is used when the Silence cannot be converted to v1alpha2.
+	ReasonMigrationInvalidSource = "InvalidSource"
+)
+
+// ConditionIdentityMigrated is the condition type reporting that the Alertmanager silence of
+// the Silence is identified by its UID, so the silence it had before is no longer looked up.
+const ConditionIdentityMigrated = "IdentityMigrated"
+
+// Condition reasons reported for ConditionIdentityMigrated.
+const (
+	// ReasonLegacySilenceMigrated is used when the silence created before identities included
+	// the UID was taken over.
+	ReasonLegacySilenceMigrated = "LegacySilenceMigrated"
+	// ReasonNoLegacySilence is used when no silence created before identities included the UID
+	// was taken over.
+	ReasonNoLegacySilence = "NoLegacySilence"
+)
+
+// SilenceStatus defines the observed state of Silence.
+type SilenceStatus struct {
+	// Conditions report the progress of the migration to observability.giantswarm.io/v1alpha2
+	// and of the Alertmanager silence identity.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
 // Silence is the Schema for the silences API.
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
 type Silence struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
 
 	Spec SilenceSpec `json:"spec"`
+
+	// +optional
+	Status SilenceStatus `json:"status,omitempty"`
 }
 
 // +kubebuilder:object:root=true
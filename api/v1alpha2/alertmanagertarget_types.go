@@ -0,0 +1,87 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AlertmanagerTargetSpec defines how the operator reaches an Alertmanager.
+type AlertmanagerTargetSpec struct {
+	// Address is the base URL of the Alertmanager API, e.g. "http://alertmanager.monitoring:9093".
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://`
+	Address string `json:"address"`
+
+	// Tenant is sent as X-Scope-OrgID header. When empty, the tenant of the silence is used.
+	// +optional
+	Tenant string `json:"tenant,omitempty"`
+
+	// Authentication configures how the operator authenticates against the Alertmanager.
+	// +optional
+	Authentication *AlertmanagerAuthentication `json:"authentication,omitempty"`
+}
+
+// AlertmanagerAuthentication configures bearer token authentication. The operator sends the
+// token to the address of the AlertmanagerTarget, so creating AlertmanagerTargets must be
+// restricted to users trusted with the tokens.
+// +kubebuilder:validation:XValidation:rule="!(self.serviceAccountToken && has(self.bearerTokenSecretRef))",message="serviceAccountToken and bearerTokenSecretRef are mutually exclusive"
+type AlertmanagerAuthentication struct {
+	// ServiceAccountToken sends the token of the operator's service account, which grants
+	// the permissions of the operator.
+	// +optional
+	ServiceAccountToken bool `json:"serviceAccountToken,omitempty"`
+
+	// BearerTokenSecretRef references the Secret key holding the bearer token. The Secret is
+	// read from the namespace of the operator.
+	// +optional
+	BearerTokenSecretRef *SecretKeyReference `json:"bearerTokenSecretRef,omitempty"`
+}
+
+// SecretKeyReference references a key of a Secret in the namespace of the operator.
+type SecretKeyReference struct {
+	// Name of the Secret.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Key in the Secret data.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+}
+
+// AlertmanagerTarget is an Alertmanager that Silences can be synced to.
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Address",type=string,JSONPath=`.spec.address`
+// +kubebuilder:printcolumn:name="Tenant",type=string,JSONPath=`.spec.tenant`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type AlertmanagerTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AlertmanagerTargetSpec `json:"spec,omitempty"`
+}
+
+// AlertmanagerTargetList contains a list of AlertmanagerTarget.
+// +kubebuilder:object:root=true
+type AlertmanagerTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AlertmanagerTarget `json:"items"`
+}
@@ -40,6 +40,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(GroupVersion,
 		&Silence{},
 		&SilenceList{},
+		&AlertmanagerTarget{},
+		&AlertmanagerTargetList{},
 	)
 
 	metav1.AddToGroupVersion(scheme, GroupVersion)
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/robfig/cron/v3"
 	str2duration "github.com/xhit/go-str2duration/v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -54,6 +55,58 @@ func (sd SilenceDuration) Duration() (time.Duration, error) {
 	return duration, nil
 }
 
+// SilenceSchedule defines a recurring silence window.
+type SilenceSchedule struct {
+	// Cron is a standard five-field cron expression defining when each window starts, e.g. "0 2 * * *".
+	// Descriptors such as "@daily" are supported as well.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Cron string `json:"cron"`
+
+	// TimeZone is the IANA time zone name the cron expression is evaluated in, e.g. "Europe/Berlin".
+	// Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Duration defines how long each window lasts.
+	// Supports weeks (w), days (d), hours (h), minutes (m), and seconds (s): "7d", "2w", "1d12h", "30m".
+	// +kubebuilder:validation:Required
+	Duration SilenceDuration `json:"duration"`
+}
+
+// Window returns the window that is active at now or, when none is, the next upcoming one.
+// When windows overlap, the earliest window still active at now is returned.
+func (ss SilenceSchedule) Window(now time.Time) (startsAt, endsAt time.Time, err error) {
+	duration, err := ss.Duration.Duration()
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if duration <= 0 {
+		return time.Time{}, time.Time{}, fmt.Errorf("schedule duration %q must be positive", ss.Duration)
+	}
+
+	location := time.UTC
+	if ss.TimeZone != "" {
+		location, err = time.LoadLocation(ss.TimeZone)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid time zone %q: %w", ss.TimeZone, err)
+		}
+	}
+
+	schedule, err := cron.ParseStandard(ss.Cron)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid cron expression %q: %w", ss.Cron, err)
+	}
+
+	// Any window still running at now started strictly after now-duration.
+	startsAt = schedule.Next(now.Add(-duration).In(location))
+	if startsAt.IsZero() {
+		return time.Time{}, time.Time{}, fmt.Errorf("cron expression %q never fires", ss.Cron)
+	}
+
+	return startsAt.UTC(), startsAt.Add(duration).UTC(), nil
+}
+
 // SilenceMatcher defines an alert matcher to be muted by the Silence.
 type SilenceMatcher struct {
 	// Name of the label to match.
@@ -78,6 +131,21 @@ type SilenceSpec struct {
 	// +kubebuilder:validation:MinItems=1
 	Matchers []SilenceMatcher `json:"matchers"`
 
+	// Description explains why the alerts are silenced. It is shown in the Alertmanager silence comment.
+	// +kubebuilder:validation:MaxLength=2048
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Owner is the person or team responsible for the silence, e.g. a username or team handle.
+	// +kubebuilder:validation:MaxLength=256
+	// +optional
+	Owner string `json:"owner,omitempty"`
+
+	// Links point to related resources such as issues, incidents or runbooks.
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	Links []SilenceLink `json:"links,omitempty"`
+
 	// StartsAt defines when the silence becomes active. Defaults to the object's creation timestamp.
 	// +optional
 	StartsAt *metav1.Time `json:"startsAt,omitempty"`
@@ -91,22 +159,189 @@ type SilenceSpec struct {
 	// Supports weeks (w), days (d), hours (h), minutes (m), and seconds (s): "7d", "2w", "1d12h", "30m".
 	// +optional
 	Duration *SilenceDuration `json:"duration,omitempty"`
+
+	// Schedule makes the silence recur. Each window is created in Alertmanager when it is reached
+	// and expires on its own. Mutually exclusive with StartsAt, EndsAt and Duration.
+	// +optional
+	Schedule *SilenceSchedule `json:"schedule,omitempty"`
+
+	// TTLAfterExpiry deletes the Silence resource once it has ended this long ago.
+	// Overrides the operator's --ttl-after-expiry flag. Not supported for recurring silences.
+	// Supports weeks (w), days (d), hours (h), minutes (m), and seconds (s): "7d", "2w", "1d12h", "30m".
+	// +optional
+	TTLAfterExpiry *SilenceDuration `json:"ttlAfterExpiry,omitempty"`
+
+	// TargetRef names the AlertmanagerTarget the silence is synced to.
+	// When neither TargetRef nor TargetSelector is set, the operator's default Alertmanager is used.
+	// +optional
+	TargetRef *AlertmanagerTargetReference `json:"targetRef,omitempty"`
+
+	// TargetSelector selects the AlertmanagerTargets the silence is synced to by label.
+	// An empty selector selects all AlertmanagerTargets. Mutually exclusive with TargetRef.
+	// +optional
+	TargetSelector *metav1.LabelSelector `json:"targetSelector,omitempty"`
+}
+
+// SilenceLink is a link to a resource related to the Silence.
+type SilenceLink struct {
+	// Name describes the link, e.g. "Incident".
+	// +kubebuilder:validation:MaxLength=64
+	// +optional
+	Name string `json:"name,omitempty"`
+	// URL of the linked resource.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=2048
+	URL string `json:"url"`
+}
+
+// AlertmanagerTargetReference references an AlertmanagerTarget by name.
+type AlertmanagerTargetReference struct {
+	// Name of the AlertmanagerTarget.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// Condition types reported in SilenceStatus.Conditions.
+const (
+	// ConditionReady is True when the silence is active in Alertmanager.
+	ConditionReady = "Ready"
+	// ConditionSynced is True when the last sync with Alertmanager succeeded.
+	ConditionSynced = "Synced"
+	// ConditionScheduled is True when the silence starts in the future.
+	ConditionScheduled = "Scheduled"
+	// ConditionExpired is True when the silence end time has passed.
+	ConditionExpired = "Expired"
+)
+
+// Condition reasons reported in SilenceStatus.Conditions.
+const (
+	// ReasonActive is used when the silence is currently muting alerts.
+	ReasonActive = "Active"
+	// ReasonPending is used when the silence start time has not been reached yet.
+	ReasonPending = "Pending"
+	// ReasonExpired is used when the silence end time has passed.
+	ReasonExpired = "Expired"
+	// ReasonSynced is used when the silence was synced to Alertmanager.
+	ReasonSynced = "Synced"
+	// ReasonSyncFailed is used when the Alertmanager API returned an error.
+	ReasonSyncFailed = "SyncFailed"
+	// ReasonRejected is used when Alertmanager rejected the silence, e.g. as invalid. The sync
+	// is not retried until the silence changes.
+	ReasonRejected = "Rejected"
+	// ReasonInvalidSpec is used when the spec cannot be converted into an Alertmanager silence.
+	ReasonInvalidSpec = "InvalidSpec"
+	// ReasonTargetNotFound is used when no AlertmanagerTarget matches spec.targetRef or spec.targetSelector.
+	ReasonTargetNotFound = "TargetNotFound"
+	// ReasonPendingApproval is used when the silence is held back until someone other than its creator approves it.
+	ReasonPendingApproval = "PendingApproval"
+)
+
+// SilenceStatus defines the observed state of Silence.
+type SilenceStatus struct {
+	// ObservedGeneration is the most recent generation observed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// SilenceID is the ID of the silence in the default Alertmanager.
+	// Silences synced to AlertmanagerTargets report their IDs in Targets.
+	// +optional
+	SilenceID string `json:"silenceID,omitempty"`
+
+	// Tenant is the Alertmanager tenant the silence is synced to.
+	// +optional
+	Tenant string `json:"tenant,omitempty"`
+
+	// StartsAt is the effective start time sent to Alertmanager.
+	// +optional
+	StartsAt *metav1.Time `json:"startsAt,omitempty"`
+
+	// EndsAt is the effective end time sent to Alertmanager.
+	// +optional
+	EndsAt *metav1.Time `json:"endsAt,omitempty"`
+
+	// LastSyncError is the error returned by the last failed sync. Cleared on success.
+	// +optional
+	LastSyncError string `json:"lastSyncError,omitempty"`
+
+	// MatchedAlerts summarizes the alerts the matchers selected in Alertmanager when they were
+	// last previewed.
+	// +optional
+	MatchedAlerts *MatchedAlertsStatus `json:"matchedAlerts,omitempty"`
+
+	// Targets reports the sync state of the silence in each AlertmanagerTarget it is synced to.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Targets []SilenceTargetStatus `json:"targets,omitempty"`
+
+	// Conditions describe the current state of the silence.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// MatchedAlertsStatus summarizes the alerts a silence matches.
+type MatchedAlertsStatus struct {
+	// Count is the number of alerts the matchers select, whether they are silenced or not.
+	Count int32 `json:"count"`
+
+	// AlertNames is a sorted sample of the alertname labels of the matched alerts.
+	// +optional
+	AlertNames []string `json:"alertNames,omitempty"`
+
+	// ObservedGeneration is the generation of the silence the alerts were previewed for.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastUpdateTime is when the alerts were previewed.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// SilenceTargetStatus reports the sync state of a silence in one AlertmanagerTarget.
+type SilenceTargetStatus struct {
+	// Name of the AlertmanagerTarget.
+	Name string `json:"name"`
+
+	// SilenceID is the ID of the silence in this Alertmanager.
+	// +optional
+	SilenceID string `json:"silenceID,omitempty"`
+
+	// Tenant is the Alertmanager tenant the silence is synced to.
+	// +optional
+	Tenant string `json:"tenant,omitempty"`
+
+	// LastSyncError is the error returned by the last failed sync to this Alertmanager.
+	// +optional
+	LastSyncError string `json:"lastSyncError,omitempty"`
 }
 
 // Silence is the Schema for the silences API.
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Namespaced
-// +kubebuilder:printcolumn:name="Starts At",type=date,JSONPath=`.spec.startsAt`
-// +kubebuilder:printcolumn:name="Ends At",type=date,JSONPath=`.spec.endsAt`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+// +kubebuilder:printcolumn:name="Starts At",type=date,JSONPath=`.status.startsAt`
+// +kubebuilder:printcolumn:name="Ends At",type=date,JSONPath=`.status.endsAt`
 // +kubebuilder:printcolumn:name="Duration",type=string,JSONPath=`.spec.duration`
+// +kubebuilder:printcolumn:name="Schedule",type=string,JSONPath=`.spec.schedule.cron`,priority=1
+// +kubebuilder:printcolumn:name="Matched",type=integer,JSONPath=`.status.matchedAlerts.count`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 // +kubebuilder:validation:XValidation:rule="!(has(self.spec.endsAt) && has(self.spec.duration))",message="endsAt and duration are mutually exclusive"
 // +kubebuilder:validation:XValidation:rule="!has(self.spec.startsAt) || !has(self.spec.endsAt) || timestamp(self.spec.startsAt) < timestamp(self.spec.endsAt)",message="startsAt must be before endsAt"
+// +kubebuilder:validation:XValidation:rule="!has(self.spec.schedule) || !(has(self.spec.startsAt) || has(self.spec.endsAt) || has(self.spec.duration))",message="schedule is mutually exclusive with startsAt, endsAt and duration"
+// +kubebuilder:validation:XValidation:rule="!(has(self.spec.schedule) && has(self.spec.ttlAfterExpiry))",message="ttlAfterExpiry cannot be set on recurring silences"
+// +kubebuilder:validation:XValidation:rule="!(has(self.spec.targetRef) && has(self.spec.targetSelector))",message="targetRef and targetSelector are mutually exclusive"
 type Silence struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec SilenceSpec `json:"spec,omitempty"`
+	Spec   SilenceSpec   `json:"spec,omitempty"`
+	Status SilenceStatus `json:"status,omitempty"`
 }
 
 // SilenceList contains a list of Silence.
@@ -59,3 +59,91 @@ func TestSilenceDuration_Duration(t *testing.T) {
 		})
 	}
 }
+
+func TestSilenceSchedule_Window(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		schedule     v1alpha2.SilenceSchedule
+		now          time.Time
+		wantStartsAt time.Time
+		wantEndsAt   time.Time
+		wantErr      bool
+	}{
+		{
+			name:         "before the first window of the day",
+			schedule:     v1alpha2.SilenceSchedule{Cron: "0 2 * * *", Duration: "4h"},
+			now:          time.Date(2026, 3, 10, 1, 0, 0, 0, time.UTC),
+			wantStartsAt: time.Date(2026, 3, 10, 2, 0, 0, 0, time.UTC),
+			wantEndsAt:   time.Date(2026, 3, 10, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "inside a window",
+			schedule:     v1alpha2.SilenceSchedule{Cron: "0 2 * * *", Duration: "4h"},
+			now:          time.Date(2026, 3, 10, 3, 30, 0, 0, time.UTC),
+			wantStartsAt: time.Date(2026, 3, 10, 2, 0, 0, 0, time.UTC),
+			wantEndsAt:   time.Date(2026, 3, 10, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "exactly at the window start",
+			schedule:     v1alpha2.SilenceSchedule{Cron: "0 2 * * *", Duration: "4h"},
+			now:          time.Date(2026, 3, 10, 2, 0, 0, 0, time.UTC),
+			wantStartsAt: time.Date(2026, 3, 10, 2, 0, 0, 0, time.UTC),
+			wantEndsAt:   time.Date(2026, 3, 10, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "exactly at the window end moves to the next window",
+			schedule:     v1alpha2.SilenceSchedule{Cron: "0 2 * * *", Duration: "4h"},
+			now:          time.Date(2026, 3, 10, 6, 0, 0, 0, time.UTC),
+			wantStartsAt: time.Date(2026, 3, 11, 2, 0, 0, 0, time.UTC),
+			wantEndsAt:   time.Date(2026, 3, 11, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "evaluated in the configured time zone",
+			schedule:     v1alpha2.SilenceSchedule{Cron: "0 2 * * *", TimeZone: "Europe/Berlin", Duration: "1h"},
+			now:          time.Date(2026, 7, 10, 0, 30, 0, 0, berlin),
+			wantStartsAt: time.Date(2026, 7, 10, 0, 0, 0, 0, time.UTC),
+			wantEndsAt:   time.Date(2026, 7, 10, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "window spanning midnight",
+			schedule:     v1alpha2.SilenceSchedule{Cron: "@daily", Duration: "1d12h"},
+			now:          time.Date(2026, 3, 10, 6, 0, 0, 0, time.UTC),
+			wantStartsAt: time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC),
+			wantEndsAt:   time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "invalid cron expression",
+			schedule: v1alpha2.SilenceSchedule{Cron: "every night", Duration: "4h"},
+			now:      time.Date(2026, 3, 10, 1, 0, 0, 0, time.UTC),
+			wantErr:  true,
+		},
+		{
+			name:     "invalid time zone",
+			schedule: v1alpha2.SilenceSchedule{Cron: "0 2 * * *", TimeZone: "Mars/Olympus", Duration: "4h"},
+			now:      time.Date(2026, 3, 10, 1, 0, 0, 0, time.UTC),
+			wantErr:  true,
+		},
+		{
+			name:     "zero duration",
+			schedule: v1alpha2.SilenceSchedule{Cron: "0 2 * * *", Duration: "0s"},
+			now:      time.Date(2026, 3, 10, 1, 0, 0, 0, time.UTC),
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			startsAt, endsAt, err := tc.schedule.Window(tc.now)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, tc.wantStartsAt.Equal(startsAt), "startsAt: want %s, got %s", tc.wantStartsAt, startsAt)
+			require.True(t, tc.wantEndsAt.Equal(endsAt), "endsAt: want %s, got %s", tc.wantEndsAt, endsAt)
+		})
+	}
+}
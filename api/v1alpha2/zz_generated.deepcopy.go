@@ -21,15 +21,169 @@ limitations under the License.
 package v1alpha2
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerAuthentication) DeepCopyInto(out *AlertmanagerAuthentication) {
+	*out = *in
+	if in.BearerTokenSecretRef != nil {
+		in, out := &in.BearerTokenSecretRef, &out.BearerTokenSecretRef
+		*out = new(SecretKeyReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerAuthentication.
+func (in *AlertmanagerAuthentication) DeepCopy() *AlertmanagerAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerTarget) DeepCopyInto(out *AlertmanagerTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerTarget.
+func (in *AlertmanagerTarget) DeepCopy() *AlertmanagerTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlertmanagerTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerTargetList) DeepCopyInto(out *AlertmanagerTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AlertmanagerTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerTargetList.
+func (in *AlertmanagerTargetList) DeepCopy() *AlertmanagerTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlertmanagerTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerTargetReference) DeepCopyInto(out *AlertmanagerTargetReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerTargetReference.
+func (in *AlertmanagerTargetReference) DeepCopy() *AlertmanagerTargetReference {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerTargetReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerTargetSpec) DeepCopyInto(out *AlertmanagerTargetSpec) {
+	*out = *in
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(AlertmanagerAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerTargetSpec.
+func (in *AlertmanagerTargetSpec) DeepCopy() *AlertmanagerTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchedAlertsStatus) DeepCopyInto(out *MatchedAlertsStatus) {
+	*out = *in
+	if in.AlertNames != nil {
+		in, out := &in.AlertNames, &out.AlertNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchedAlertsStatus.
+func (in *MatchedAlertsStatus) DeepCopy() *MatchedAlertsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MatchedAlertsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeyReference) DeepCopyInto(out *SecretKeyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyReference.
+func (in *SecretKeyReference) DeepCopy() *SecretKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Silence) DeepCopyInto(out *Silence) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Silence.
@@ -50,6 +204,21 @@ func (in *Silence) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SilenceLink) DeepCopyInto(out *SilenceLink) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SilenceLink.
+func (in *SilenceLink) DeepCopy() *SilenceLink {
+	if in == nil {
+		return nil
+	}
+	out := new(SilenceLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SilenceList) DeepCopyInto(out *SilenceList) {
 	*out = *in
@@ -97,6 +266,21 @@ func (in *SilenceMatcher) DeepCopy() *SilenceMatcher {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SilenceSchedule) DeepCopyInto(out *SilenceSchedule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SilenceSchedule.
+func (in *SilenceSchedule) DeepCopy() *SilenceSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(SilenceSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SilenceSpec) DeepCopyInto(out *SilenceSpec) {
 	*out = *in
@@ -105,6 +289,11 @@ func (in *SilenceSpec) DeepCopyInto(out *SilenceSpec) {
 		*out = make([]SilenceMatcher, len(*in))
 		copy(*out, *in)
 	}
+	if in.Links != nil {
+		in, out := &in.Links, &out.Links
+		*out = make([]SilenceLink, len(*in))
+		copy(*out, *in)
+	}
 	if in.StartsAt != nil {
 		in, out := &in.StartsAt, &out.StartsAt
 		*out = (*in).DeepCopy()
@@ -118,6 +307,26 @@ func (in *SilenceSpec) DeepCopyInto(out *SilenceSpec) {
 		*out = new(SilenceDuration)
 		**out = **in
 	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(SilenceSchedule)
+		**out = **in
+	}
+	if in.TTLAfterExpiry != nil {
+		in, out := &in.TTLAfterExpiry, &out.TTLAfterExpiry
+		*out = new(SilenceDuration)
+		**out = **in
+	}
+	if in.TargetRef != nil {
+		in, out := &in.TargetRef, &out.TargetRef
+		*out = new(AlertmanagerTargetReference)
+		**out = **in
+	}
+	if in.TargetSelector != nil {
+		in, out := &in.TargetSelector, &out.TargetSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SilenceSpec.
@@ -129,3 +338,58 @@ func (in *SilenceSpec) DeepCopy() *SilenceSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SilenceStatus) DeepCopyInto(out *SilenceStatus) {
+	*out = *in
+	if in.StartsAt != nil {
+		in, out := &in.StartsAt, &out.StartsAt
+		*out = (*in).DeepCopy()
+	}
+	if in.EndsAt != nil {
+		in, out := &in.EndsAt, &out.EndsAt
+		*out = (*in).DeepCopy()
+	}
+	if in.MatchedAlerts != nil {
+		in, out := &in.MatchedAlerts, &out.MatchedAlerts
+		*out = new(MatchedAlertsStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]SilenceTargetStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SilenceStatus.
+func (in *SilenceStatus) DeepCopy() *SilenceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SilenceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SilenceTargetStatus) DeepCopyInto(out *SilenceTargetStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SilenceTargetStatus.
+func (in *SilenceTargetStatus) DeepCopy() *SilenceTargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SilenceTargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -21,11 +21,17 @@ import (
 	"flag"
 	"os"
 	"path/filepath"
+	"time"
+
+	// Embed the IANA time zone database so recurring silence schedules can be evaluated
+	// in any time zone regardless of the base image.
+	_ "time/tzdata"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -40,7 +46,10 @@ import (
 	monitoringv1alpha1 "github.com/giantswarm/silence-operator/api/v1alpha1"
 	observabilityv1alpha2 "github.com/giantswarm/silence-operator/api/v1alpha2"
 	"github.com/giantswarm/silence-operator/internal/controller"
+	webhookv1alpha1 "github.com/giantswarm/silence-operator/internal/webhook/v1alpha1"
+	webhookv1alpha2 "github.com/giantswarm/silence-operator/internal/webhook/v1alpha2"
 	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+	"github.com/giantswarm/silence-operator/pkg/approval"
 	"github.com/giantswarm/silence-operator/pkg/config"
 	"github.com/giantswarm/silence-operator/pkg/service"
 	"github.com/giantswarm/silence-operator/pkg/tenancy"
@@ -66,6 +75,8 @@ func main() {
 	var metricsCertPath, metricsCertName, metricsCertKey string
 	var webhookCertPath, webhookCertName, webhookCertKey string
 	var enableLeaderElection bool
+	var enableWebhooks bool
+	var enableMigration bool
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
@@ -74,14 +85,21 @@ func main() {
 	var cfg config.Config
 	var silenceSelector string
 	var namespaceSelector string
+	var migrationSelector string
+	var adoptionCreatedBy, adoptionComment string
+	var instance string
+	httpClientFlags := config.HTTPClientFlags{Headers: map[string]string{}}
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
-		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
+		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service, which also serves /debug/alertmanager.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
 	flag.BoolVar(&secureMetrics, "metrics-secure", false, // TODO See with @shield how to set this up
 		"If set, the metrics endpoint is served securely via HTTPS. Use --metrics-secure=false to use HTTP instead.")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"If set, the admission webhooks for Silence resources are served. "+
+			"Requires the webhook configurations and a serving certificate (see --webhook-cert-path).")
 	flag.StringVar(&webhookCertPath, "webhook-cert-path", "", "The directory that contains the webhook certificate.")
 	flag.StringVar(&webhookCertName, "webhook-cert-name", "tls.crt", "The name of the webhook certificate file.")
 	flag.StringVar(&webhookCertKey, "webhook-cert-key", "tls.key", "The name of the webhook key file.")
@@ -91,11 +109,71 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.StringVar(&instance, "instance", "",
+		"Name of this installation of the operator among the ones sharing Alertmanagers, stamped into the silences it creates so that it only touches its own. Empty is the default installation.")
 	flag.StringVar(&cfg.Address, "alertmanager-address", "http://localhost:9093", "Alertmanager address used to create silences.")
 	flag.StringVar(&cfg.TenantId, "alertmanager-default-tenant-id", "", "Alertmanager tenant id.")
 	flag.BoolVar(&cfg.Authentication, "alertmanager-authentication", false, "Enable Alertmanager authentication using Service Account token.")
+	flag.StringVar(&cfg.SecretNamespace, "alertmanager-target-secret-namespace", os.Getenv("POD_NAMESPACE"),
+		"Namespace of the operator, the only namespace bearer token Secrets of AlertmanagerTargets are read from. Defaults to $POD_NAMESPACE.")
+	flag.DurationVar(&cfg.RequestTimeout, "alertmanager-timeout", 10*time.Second, "Timeout of each request to Alertmanager. 0 disables the timeout.")
+	flag.IntVar(&cfg.MaxRetries, "alertmanager-max-retries", 3,
+		"How often requests to Alertmanager that are safe to repeat, such as listing or expiring silences, are retried after transient failures.")
+	flag.Float64Var(&cfg.RateLimit, "alertmanager-rate-limit", 20,
+		"Calls per second to Alertmanager, shared by the default Alertmanager and all AlertmanagerTargets. 0 disables the rate limit.")
+	flag.IntVar(&cfg.RateLimitBurst, "alertmanager-rate-limit-burst", 40, "Number of calls to Alertmanager that may exceed the rate limit at once.")
+	flag.IntVar(&cfg.CircuitBreakerThreshold, "alertmanager-circuit-breaker-threshold", 5,
+		"Consecutive failed calls to an Alertmanager after which calls to it fail fast. 0 disables the circuit breaker.")
+	flag.DurationVar(&cfg.CircuitBreakerCooldown, "alertmanager-circuit-breaker-cooldown", 30*time.Second,
+		"How long calls to a failing Alertmanager fail fast before a call probes whether it recovered.")
+	flag.StringVar(&httpClientFlags.ConfigFile, "alertmanager-http-config-file", "",
+		"Path to a file configuring the Alertmanager HTTP client in the Prometheus http_config format. The --alertmanager-* client flags override its settings.")
+	flag.StringVar(&httpClientFlags.BasicAuthUsername, "alertmanager-basic-auth-username", "", "Username for Alertmanager basic authentication.")
+	flag.StringVar(&httpClientFlags.BasicAuthPasswordFile, "alertmanager-basic-auth-password-file", "", "Path to the password for Alertmanager basic authentication.")
+	flag.StringVar(&httpClientFlags.BearerTokenFile, "alertmanager-bearer-token-file", "", "Path to the bearer token sent to Alertmanager. The file is read on every request.")
+	flag.StringVar(&httpClientFlags.CAFile, "alertmanager-tls-ca-file", "", "Path to the CA bundle verifying the Alertmanager server certificate.")
+	flag.StringVar(&httpClientFlags.CertFile, "alertmanager-tls-cert-file", "", "Path to the client certificate presented to Alertmanager.")
+	flag.StringVar(&httpClientFlags.KeyFile, "alertmanager-tls-key-file", "", "Path to the key of the client certificate presented to Alertmanager.")
+	flag.StringVar(&httpClientFlags.ServerName, "alertmanager-tls-server-name", "", "Server name the Alertmanager certificate is verified against.")
+	flag.BoolVar(&httpClientFlags.InsecureSkipVerify, "alertmanager-tls-insecure-skip-verify", false, "Skip verifying the Alertmanager server certificate.")
+	flag.StringVar(&httpClientFlags.ProxyURL, "alertmanager-proxy-url", "", "URL of the HTTP proxy Alertmanager is reached through.")
+	flag.Func("alertmanager-header", "Header sent with every Alertmanager request, as 'Name: value'. May be repeated.", func(header string) error {
+		return config.ParseHeader(httpClientFlags.Headers, header)
+	})
+	flag.DurationVar(&cfg.SilenceCacheFreshness, "silence-cache-freshness", 30*time.Second,
+		"How long silences listed from an Alertmanager tenant are shared between reconciliations. Writes by the operator refresh them earlier. 0 disables the cache.")
+	flag.DurationVar(&cfg.ReadinessCheckInterval, "alertmanager-readiness-check-interval", 30*time.Second,
+		"How often to check that Alertmanager is ready and the silences of the default tenant can be listed. 0 disables the check. "+
+			"The result is exported as silence_operator_alertmanager_ready and served on /debug/alertmanager of the metrics endpoint, which is disabled unless --metrics-bind-address is set.")
+	flag.DurationVar(&cfg.DriftDetectionInterval, "drift-detection-interval", 5*time.Minute,
+		"How often v1alpha2 Silences are compared with Alertmanager to re-sync silences that were expired or changed there. 0 disables drift detection.")
+	flag.DurationVar(&cfg.OrphanSweepInterval, "orphan-sweep-interval", 0,
+		"How often Alertmanager silences created by the operator whose Silence no longer exists are expired. 0 disables the sweep.")
+	flag.DurationVar(&cfg.OrphanGracePeriod, "orphan-grace-period", time.Hour, "How long after their last update orphaned silences are kept before they are expired.")
+	flag.DurationVar(&cfg.AdoptionInterval, "adoption-interval", 0,
+		"How often silences created outside of the operator in the default Alertmanager are adopted into v1alpha2 Silences. 0 disables the adoption.")
+	flag.StringVar(&adoptionCreatedBy, "adoption-created-by", "", "Regular expression selecting the silences to adopt by their creator (e.g., '^(alice|bob)$').")
+	flag.StringVar(&adoptionComment, "adoption-comment", "", "Regular expression selecting the silences to adopt by their comment (e.g., 'maintenance').")
+	flag.StringVar(&cfg.AdoptionNamespaceLabel, "adoption-namespace-label", "", "Matcher label whose value is the namespace an adopted silence is created in (e.g., 'namespace').")
+	flag.StringVar(&cfg.AdoptionDefaultNamespace, "adoption-default-namespace", "", "Namespace of adopted silences without an equality matcher on --adoption-namespace-label. If empty, they are not adopted.")
+	flag.BoolVar(&cfg.OrphanSweepDryRun, "orphan-sweep-dry-run", false, "Only log and count orphaned silences instead of expiring them.")
 	flag.StringVar(&silenceSelector, "silence-selector", "", "Label selector to filter Silence custom resources (e.g., 'environment=production,tier=frontend').")
 	flag.StringVar(&namespaceSelector, "namespace-selector", "", "Label selector to restrict which namespaces the v2 controller watches (e.g., 'environment=production'). If empty, all namespaces are watched.")
+	flag.BoolVar(&enableMigration, "migration-enabled", false, "Enable the controller migrating v1alpha1 Silences to v1alpha2. "+
+		"Only Silences annotated with '"+controller.MigrateToNamespaceAnnotation+"' or matching --migration-selector are migrated.")
+	flag.StringVar(&migrationSelector, "migration-selector", "", "Label selector of v1alpha1 Silences to migrate in addition to annotated ones (e.g., 'team=platform').")
+	flag.StringVar(&cfg.MigrationTargetNamespace, "migration-target-namespace", "", "Namespace v1alpha1 Silences are migrated into unless their migration annotation names another one.")
+	flag.IntVar(&cfg.MatchedAlertsWarningThreshold, "matched-alerts-warning-threshold", 100,
+		"The admission webhook warns when a new v1alpha2 Silence matches at least this many firing alerts. 0 disables the warning.")
+	flag.DurationVar(&cfg.MatchedAlertsRefreshInterval, "matched-alerts-refresh-interval", 10*time.Minute,
+		"How old the firing alerts previewed in the status of a v1alpha2 Silence may get before they are listed again when the Silence is reconciled. "+
+			"They are always listed again when the Silence changes. 0 only lists them then.")
+	flag.DurationVar(&cfg.TTLAfterExpiry, "ttl-after-expiry", 0, "Delete v1alpha2 Silences that ended this long ago, unless they set spec.ttlAfterExpiry. 0 keeps ended Silences.")
+	flag.BoolVar(&cfg.ApprovalEnabled, "approval-enabled", false, "Hold back v1alpha2 Silences matching the approval criteria until someone other than their creator sets the '"+
+		approval.ApprovedByAnnotation+"' annotation. Requires --enable-webhooks, which records creators and approvers.")
+	flag.DurationVar(&cfg.ApprovalMaxDuration, "approval-max-duration", 7*24*time.Hour, "Silences lasting longer than this require approval. 0 disables the check.")
+	flag.IntVar(&cfg.ApprovalMinMatchers, "approval-min-matchers", 2, "Silences with fewer matchers than this require approval. 0 disables the check.")
+	flag.BoolVar(&cfg.ApprovalRegexWildcards, "approval-regex-wildcards", true, "Silences with regex matchers that match any value, such as '.*', require approval.")
 	// Tenancy flags (not wired up yet - for future PRs)
 	flag.BoolVar(&cfg.TenancyEnabled, "tenancy-enabled", false, "Enable tenancy support for multi-tenant Alertmanager setups.")
 	flag.StringVar(&cfg.TenancyLabelKey, "tenancy-label-key", "observability.giantswarm.io/tenant", "Label key to extract tenant information from Silence resources.")
@@ -108,6 +186,12 @@ func main() {
 	flag.Parse()
 
 	var err error
+	cfg.Instance, err = config.ParseInstance(instance)
+	if err != nil {
+		setupLog.Error(err, "failed to parse instance", "instance", instance)
+		os.Exit(1)
+	}
+
 	cfg.SilenceSelector, err = config.ParseSilenceSelector(silenceSelector)
 	if err != nil {
 		setupLog.Error(err, "failed to parse silence selector", "selector", silenceSelector)
@@ -120,6 +204,45 @@ func main() {
 		os.Exit(1)
 	}
 
+	cfg.MigrationSelector, err = config.ParseMigrationSelector(migrationSelector)
+	if err != nil {
+		setupLog.Error(err, "failed to parse migration selector", "selector", migrationSelector)
+		os.Exit(1)
+	}
+
+	cfg.AdoptionCreatedBy, err = config.ParseAdoptionPattern("adoption-created-by", adoptionCreatedBy)
+	if err != nil {
+		setupLog.Error(err, "failed to parse adoption creator pattern", "pattern", adoptionCreatedBy)
+		os.Exit(1)
+	}
+
+	cfg.AdoptionComment, err = config.ParseAdoptionPattern("adoption-comment", adoptionComment)
+	if err != nil {
+		setupLog.Error(err, "failed to parse adoption comment pattern", "pattern", adoptionComment)
+		os.Exit(1)
+	}
+
+	if cfg.ApprovalEnabled && !enableWebhooks {
+		setupLog.Error(nil, "--approval-enabled requires --enable-webhooks to record the creators and approvers of silences")
+		os.Exit(1)
+	}
+
+	if cfg.AdoptionInterval > 0 && cfg.AdoptionCreatedBy == nil && cfg.AdoptionComment == nil {
+		setupLog.Error(nil, "adoption requires --adoption-created-by or --adoption-comment")
+		os.Exit(1)
+	}
+
+	cfg.HTTPClient, err = config.LoadHTTPClientConfig(httpClientFlags)
+	if err != nil {
+		setupLog.Error(err, "failed to load Alertmanager HTTP client config", "file", httpClientFlags.ConfigFile)
+		os.Exit(1)
+	}
+
+	if cfg.Authentication && config.HasAuthentication(cfg.HTTPClient) {
+		setupLog.Error(nil, "--alertmanager-authentication cannot be combined with authentication in the Alertmanager HTTP client config")
+		os.Exit(1)
+	}
+
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
@@ -237,9 +360,6 @@ func main() {
 
 	cfg.BearerToken = mgr.GetConfig().BearerToken
 
-	// TODO Make this more configurable:
-	// - support all alertmanager auth methods
-	// - support multiple tenant (configurable label like observability.giantswarm.io/tenant)
 	var amClient *alertmanager.Alertmanager
 	{
 		amClient, err = alertmanager.New(cfg)
@@ -253,17 +373,36 @@ func main() {
 	tenancyHelper := tenancy.NewHelper(cfg)
 
 	// Create the silence service
-	silenceService := service.NewSilenceService(amClient)
-	if err = controller.NewSilenceReconciler(mgr.GetClient(), silenceService, tenancyHelper).
+	silenceService := service.NewSilenceService(amClient, cfg.SilenceCacheFreshness)
+	guard := alertmanager.NewGuard(cfg)
+	silenceService.UseGuard(guard)
+	if err = controller.NewSilenceReconciler(mgr.GetClient(), mgr.GetEventRecorder("silence"), silenceService, tenancyHelper).
 		SetupWithManager(mgr, cfg); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Silence")
 		os.Exit(1)
 	}
-	if err = controller.NewSilenceV2Reconciler(mgr.GetClient(), silenceService, tenancyHelper).
+	if err = controller.NewSilenceV2Reconciler(mgr.GetClient(), mgr.GetEventRecorder("silence-v2"), silenceService, tenancyHelper).
 		SetupWithManager(mgr, cfg); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "SilenceV2")
 		os.Exit(1)
 	}
+	if enableMigration {
+		if err = controller.NewSilenceMigrationReconciler(mgr.GetClient(), mgr.GetEventRecorder("silence-migration"),
+			cfg.MigrationSelector, cfg.MigrationTargetNamespace).SetupWithManager(mgr, cfg); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "SilenceMigration")
+			os.Exit(1)
+		}
+	}
+	if enableWebhooks {
+		if err = webhookv1alpha1.SetupSilenceWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Silence", "version", "v1alpha1")
+			os.Exit(1)
+		}
+		if err = webhookv1alpha2.SetupSilenceWebhookWithManager(mgr, silenceService, tenancyHelper, cfg); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Silence", "version", "v1alpha2")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if metricsCertWatcher != nil {
@@ -290,6 +429,18 @@ func main() {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	if cfg.ReadinessCheckInterval > 0 {
+		readinessChecker := alertmanager.NewReadinessChecker(amClient, tenancyHelper.ExtractTenant(&metav1.ObjectMeta{}), cfg.ReadinessCheckInterval)
+		readinessChecker.UseGuard(guard)
+		if err := mgr.Add(readinessChecker); err != nil {
+			setupLog.Error(err, "unable to add Alertmanager readiness checker to manager")
+			os.Exit(1)
+		}
+		if err := mgr.AddMetricsServerExtraHandler("/debug/alertmanager", readinessChecker); err != nil {
+			setupLog.Error(err, "unable to set up Alertmanager debug endpoint")
+			os.Exit(1)
+		}
+	}
 
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
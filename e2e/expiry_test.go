@@ -21,7 +21,6 @@ var _ = Describe("Silence expiry", func() {
 	)
 
 	ctx := context.Background()
-	expectedComment := "silence-operator-" + namespace + "-" + silenceName
 
 	BeforeEach(func() {
 		Expect(createNamespace(ctx, namespace)).To(Succeed())
@@ -61,6 +60,7 @@ var _ = Describe("Silence expiry", func() {
 			},
 		}
 		Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+		expectedComment := alertmanager.SilenceComment(silence)
 
 		Consistently(func() *alertmanager.Silence {
 			s, _ := findSilenceByComment(amPortForward.localPort, expectedComment)
@@ -127,7 +127,7 @@ func getActiveSilences(port int) ([]alertmanager.Silence, error) {
 	return active, nil
 }
 
-// findSilenceByComment finds an active silence with the given comment.
+// findSilenceByComment finds an active silence with the given comment identity.
 func findSilenceByComment(port int, comment string) (*alertmanager.Silence, error) {
 	silences, err := getActiveSilences(port)
 	if err != nil {
@@ -135,7 +135,7 @@ func findSilenceByComment(port int, comment string) (*alertmanager.Silence, erro
 	}
 
 	for _, s := range silences {
-		if s.Comment == comment {
+		if alertmanager.CommentIdentity(s.Comment) == comment {
 			return &s, nil
 		}
 	}
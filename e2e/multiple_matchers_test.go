@@ -22,7 +22,6 @@ var _ = Describe("Multiple matchers", func() {
 	)
 
 	ctx := context.Background()
-	expectedComment := "silence-operator-" + namespace + "-" + silenceName
 
 	BeforeEach(func() {
 		Expect(createNamespace(ctx, namespace)).To(Succeed())
@@ -69,6 +68,7 @@ var _ = Describe("Multiple matchers", func() {
 			},
 		}
 		Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+		expectedComment := alertmanager.SilenceComment(silence)
 
 		var amSilence *alertmanager.Silence
 		Eventually(func() error {
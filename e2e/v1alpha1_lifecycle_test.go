@@ -20,7 +20,6 @@ var _ = Describe("v1alpha1 Silence lifecycle", func() {
 
 	It("should create a silence in Alertmanager when a v1alpha1 Silence CR is created", func() {
 		const silenceName = "test-v1-create"
-		expectedComment := "silence-operator-" + silenceName
 
 		silence := &v1alpha1.Silence{
 			ObjectMeta: metav1.ObjectMeta{
@@ -36,6 +35,7 @@ var _ = Describe("v1alpha1 Silence lifecycle", func() {
 			},
 		}
 		Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+		expectedComment := alertmanager.SilenceComment(silence)
 
 		DeferCleanup(func() {
 			_ = client.IgnoreNotFound(k8sClient.Delete(ctx, silence))
@@ -65,7 +65,6 @@ var _ = Describe("v1alpha1 Silence lifecycle", func() {
 
 	It("should remove the silence from Alertmanager when the v1alpha1 Silence CR is deleted", func() {
 		const silenceName = "test-v1-delete"
-		expectedComment := "silence-operator-" + silenceName
 
 		silence := &v1alpha1.Silence{
 			ObjectMeta: metav1.ObjectMeta{
@@ -81,6 +80,7 @@ var _ = Describe("v1alpha1 Silence lifecycle", func() {
 			},
 		}
 		Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+		expectedComment := alertmanager.SilenceComment(silence)
 
 		Eventually(func() *alertmanager.Silence {
 			s, _ := findSilenceByComment(amPortForward.localPort, expectedComment)
@@ -23,7 +23,6 @@ var _ = Describe("v1alpha2 Silence lifecycle", func() {
 			namespace   = "test-v2-create"
 			silenceName = "test-create"
 		)
-		expectedComment := "silence-operator-" + namespace + "-" + silenceName
 
 		Expect(createNamespace(ctx, namespace)).To(Succeed())
 
@@ -43,6 +42,7 @@ var _ = Describe("v1alpha2 Silence lifecycle", func() {
 			},
 		}
 		Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+		expectedComment := alertmanager.SilenceComment(silence)
 
 		DeferCleanup(func() {
 			_ = client.IgnoreNotFound(k8sClient.Delete(ctx, silence))
@@ -75,7 +75,6 @@ var _ = Describe("v1alpha2 Silence lifecycle", func() {
 			namespace   = "test-v2-delete"
 			silenceName = "test-delete"
 		)
-		expectedComment := "silence-operator-" + namespace + "-" + silenceName
 
 		Expect(createNamespace(ctx, namespace)).To(Succeed())
 
@@ -95,6 +94,7 @@ var _ = Describe("v1alpha2 Silence lifecycle", func() {
 			},
 		}
 		Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+		expectedComment := alertmanager.SilenceComment(silence)
 
 		Eventually(func() *alertmanager.Silence {
 			s, _ := findSilenceByComment(amPortForward.localPort, expectedComment)
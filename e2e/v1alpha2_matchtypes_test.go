@@ -52,7 +52,6 @@ var _ = Describe("v1alpha2 Silence match types", func() {
 			suffix := sanitizeMatchType(tc.matchType)
 			namespace := fmt.Sprintf("test-matchtype-%s", suffix)
 			silenceName := fmt.Sprintf("test-mt-%s", suffix)
-			expectedComment := "silence-operator-" + namespace + "-" + silenceName
 
 			Expect(createNamespace(ctx, namespace)).To(Succeed())
 
@@ -72,6 +71,7 @@ var _ = Describe("v1alpha2 Silence match types", func() {
 				},
 			}
 			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+			expectedComment := alertmanager.SilenceComment(silence)
 
 			DeferCleanup(func() {
 				_ = client.IgnoreNotFound(k8sClient.Delete(ctx, silence))
@@ -11,6 +11,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/giantswarm/silence-operator/api/v1alpha2"
+	"github.com/giantswarm/silence-operator/pkg/alertmanager"
 )
 
 var _ = Describe("v1alpha2 Silence update", func() {
@@ -20,7 +21,6 @@ var _ = Describe("v1alpha2 Silence update", func() {
 	)
 
 	ctx := context.Background()
-	expectedComment := "silence-operator-" + namespace + "-" + silenceName
 
 	BeforeEach(func() {
 		Expect(createNamespace(ctx, namespace)).To(Succeed())
@@ -57,6 +57,7 @@ var _ = Describe("v1alpha2 Silence update", func() {
 			},
 		}
 		Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+		expectedComment := alertmanager.SilenceComment(silence)
 
 		// Wait for the silence to appear in AM with original value.
 		Eventually(func() string {
@@ -0,0 +1,107 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+	"github.com/giantswarm/silence-operator/pkg/metrics"
+	"github.com/giantswarm/silence-operator/pkg/service"
+)
+
+// Reasons of the events recorded for the lifecycle of the Alertmanager silence of a Silence.
+const (
+	eventReasonCreated    = "Created"
+	eventReasonUpdated    = "Updated"
+	eventReasonExpired    = "Expired"
+	eventReasonDeleted    = "Deleted"
+	eventReasonSyncFailed = "SyncFailed"
+	eventReasonRejected   = "Rejected"
+)
+
+// maxEventNoteLength is the longest note the API server accepts for an event.
+const maxEventNoteLength = 1024
+
+// recordSyncEvent records the creation or update of the Alertmanager silence in the
+// default Alertmanager, or in the AlertmanagerTarget called target, by a sync. Expiry is
+// recorded by recordExpiredEvent, and syncs that changed nothing are not recorded.
+func recordSyncEvent(recorder events.EventRecorder, obj runtime.Object, result service.SyncResult, desired *alertmanager.Silence, target string) {
+	where := "Alertmanager"
+	if target != "" {
+		where = "AlertmanagerTarget " + target
+	}
+
+	var reason, action, note string
+	switch result.Outcome {
+	case metrics.SyncOutcomeCreated:
+		reason, action = eventReasonCreated, "Create"
+		note = fmt.Sprintf("Created silence %s in %s, active from %s until %s", result.SilenceID, where,
+			formatEventTime(desired.StartsAt), formatEventTime(desired.EndsAt))
+	case metrics.SyncOutcomeUpdated:
+		reason, action = eventReasonUpdated, "Update"
+		note = fmt.Sprintf("Updated silence %s in %s: %s", result.SilenceID, where, strings.Join(result.Changes, "; "))
+		if result.ReplacedID != "" {
+			note = fmt.Sprintf("Replaced silence %s with %s in %s: %s", result.ReplacedID, result.SilenceID, where, strings.Join(result.Changes, "; "))
+		}
+	default:
+		return
+	}
+	recorder.Eventf(obj, nil, corev1.EventTypeNormal, reason, action, "%s", truncate(note, maxEventNoteLength))
+}
+
+// logSyncChanges logs what an update changed in the Alertmanager silence in the default
+// Alertmanager, or in the AlertmanagerTarget called target, so that unexpected updates can
+// be traced back to the field that differed.
+func logSyncChanges(ctx context.Context, result service.SyncResult, target string) {
+	if result.Outcome != metrics.SyncOutcomeUpdated {
+		return
+	}
+	log.FromContext(ctx).Info("Updated silence in Alertmanager", "target", target, "id", result.SilenceID,
+		"replacedID", result.ReplacedID, "changes", result.Changes)
+}
+
+// recordSyncFailedEvent records a warning with the error of a failed sync.
+func recordSyncFailedEvent(recorder events.EventRecorder, obj runtime.Object, reason string, err error) {
+	note := fmt.Sprintf("Failed to sync silence with Alertmanager: %s", err)
+	recorder.Eventf(obj, nil, corev1.EventTypeWarning, reason, "Sync", "%s", truncate(note, maxEventNoteLength))
+}
+
+// recordExpiredEvent records that the silence ended at endsAt, whether Alertmanager expired
+// it on its own or the operator expired it.
+func recordExpiredEvent(recorder events.EventRecorder, obj runtime.Object, endsAt time.Time) {
+	recorder.Eventf(obj, nil, corev1.EventTypeNormal, eventReasonExpired, "Expire", "Silence expired at %s", formatEventTime(endsAt))
+}
+
+// recordDeletedEvent records that the silence was deleted from Alertmanager when its
+// Silence was deleted.
+func recordDeletedEvent(recorder events.EventRecorder, obj runtime.Object) {
+	recorder.Eventf(obj, nil, corev1.EventTypeNormal, eventReasonDeleted, "Delete", "Deleted silence from Alertmanager")
+}
+
+// formatEventTime renders t in UTC with second precision.
+func formatEventTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
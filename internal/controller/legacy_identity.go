@@ -0,0 +1,112 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/giantswarm/silence-operator/api/v1alpha1"
+	"github.com/giantswarm/silence-operator/api/v1alpha2"
+	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+	"github.com/giantswarm/silence-operator/pkg/service"
+)
+
+// findLegacySilence returns the ID of the Alertmanager silence created for silence before
+// identities included the UID, so that syncing desired with the ID migrates it in place.
+// v1alpha1 Silence `<namespace>-<name>` and v1alpha2 Silence `<name>` in `<namespace>` have
+// the same legacy identity, so nothing is returned while a Silence of the other API version
+// has the legacy identity of silence.
+func findLegacySilence(ctx context.Context, c client.Reader, silenceService *service.SilenceService, silence client.Object, desired *alertmanager.Silence, tenant string) (string, error) {
+	claimed, err := legacyIdentityClaimed(ctx, c, silence)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to look up Silences with the same legacy identity")
+	}
+	if claimed {
+		log.FromContext(ctx).Info("Not migrating Alertmanager silence with legacy identity, as a Silence of the other API version has the same legacy identity",
+			"legacyIdentity", alertmanager.ObjectIdentity(silence).Legacy())
+		return "", nil
+	}
+	return silenceService.FindLegacySilence(ctx, desired, tenant)
+}
+
+// deleteLegacySilences deletes the Alertmanager silences created for silence before
+// identities included the UID, whatever their matchers, unless a Silence of the other API
+// version has the same legacy identity. Such silences are left behind when silence is deleted
+// before it was synced, or when their matchers differed so that they were not migrated.
+func deleteLegacySilences(ctx context.Context, c client.Reader, silenceService *service.SilenceService, silence client.Object, comment, tenant string) error {
+	claimed, err := legacyIdentityClaimed(ctx, c, silence)
+	if err != nil {
+		return errors.Wrap(err, "failed to look up Silences with the same legacy identity")
+	}
+	if claimed {
+		return nil
+	}
+	return silenceService.DeleteLegacySilences(ctx, comment, tenant)
+}
+
+// legacyIdentityClaimed returns true when a Silence of the other API version than silence
+// has the same legacy identity, see alertmanager.Identity.Legacy.
+func legacyIdentityClaimed(ctx context.Context, c client.Reader, silence client.Object) (bool, error) {
+	switch silence.(type) {
+	case *v1alpha1.Silence:
+		// Any dash of the name may separate a namespace from a name.
+		name := silence.GetName()
+		for i := strings.Index(name, "-"); i >= 0; i = nextDash(name, i) {
+			namespace := name[:i]
+			if len(validation.IsDNS1123Label(namespace)) > 0 {
+				continue
+			}
+			key := client.ObjectKey{Namespace: namespace, Name: name[i+1:]}
+			if found, err := exists(c.Get(ctx, key, &v1alpha2.Silence{})); found || err != nil {
+				return found, err
+			}
+		}
+	case *v1alpha2.Silence:
+		key := client.ObjectKey{Name: silence.GetNamespace() + "-" + silence.GetName()}
+		return exists(c.Get(ctx, key, &v1alpha1.Silence{}))
+	}
+	return false, nil
+}
+
+// nextDash returns the index of the first dash in s after index i, or -1.
+func nextDash(s string, i int) int {
+	j := strings.Index(s[i+1:], "-")
+	if j < 0 {
+		return -1
+	}
+	return i + 1 + j
+}
+
+// exists interprets the error of a Get. A missing resource or CRD means that the resource
+// does not exist.
+func exists(err error) (bool, error) {
+	switch {
+	case err == nil:
+		return true, nil
+	case apierrors.IsNotFound(err), meta.IsNoMatchError(err):
+		return false, nil
+	}
+	return false, errors.WithStack(err)
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/giantswarm/silence-operator/api/v1alpha1"
+	"github.com/giantswarm/silence-operator/api/v1alpha2"
+	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+	"github.com/giantswarm/silence-operator/pkg/metrics"
+	"github.com/giantswarm/silence-operator/pkg/service"
+)
+
+// orphanSweeper periodically expires Alertmanager silences created by the operator whose
+// Silence no longer exists, e.g. because its finalizer was removed by hand or the operator
+// was down while it was deleted. It runs on the leader only.
+type orphanSweeper struct {
+	reconciler *SilenceV2Reconciler
+	interval   time.Duration
+	// gracePeriod is how long after its last update an orphaned silence is kept, so that
+	// silences whose Silence is not in the cache yet are not expired.
+	gracePeriod time.Duration
+	// dryRun only logs and counts orphaned silences instead of expiring them.
+	dryRun bool
+}
+
+// Start sweeps every interval until ctx is done.
+func (s *orphanSweeper) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("orphan-sweeper")
+	ctx = log.IntoContext(ctx, logger)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := s.sweep(ctx); err != nil {
+				metrics.OrphanSweeps.WithLabelValues("error").Inc()
+				logger.Error(err, "Failed to sweep orphaned silences")
+				continue
+			}
+			metrics.OrphanSweeps.WithLabelValues("success").Inc()
+		}
+	}
+}
+
+// sweep expires the orphaned silences past their grace period in the default
+// Alertmanager and in every AlertmanagerTarget, for each tenant the Silences map to.
+// Targets that cannot be listed are skipped and reported in the returned error.
+func (s *orphanSweeper) sweep(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+	r := s.reconciler
+
+	owned, tenants, err := s.ownedSilences(ctx)
+	if err != nil {
+		return err
+	}
+
+	amTargets := &v1alpha2.AlertmanagerTargetList{}
+	if err := r.client.List(ctx, amTargets); err != nil {
+		return errors.Wrap(err, "failed to list AlertmanagerTargets")
+	}
+
+	var targets []service.Target
+	for tenant := range tenants {
+		targets = append(targets, r.silenceService.DefaultTarget(tenant))
+	}
+	var errs []error
+	for i := range amTargets.Items {
+		amTarget := &amTargets.Items[i]
+		targetTenants := tenants
+		if amTarget.Spec.Tenant != "" {
+			targetTenants = map[string]bool{amTarget.Spec.Tenant: true}
+		}
+		for tenant := range targetTenants {
+			target, err := r.target(ctx, amTarget, tenant)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			targets = append(targets, target)
+		}
+	}
+
+	orphans := 0
+	for _, target := range targets {
+		silences, err := r.silenceService.ListSilences(ctx, target)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "target %q, tenant %q", target.Name, target.Tenant))
+			continue
+		}
+
+		for _, silence := range silences {
+			identity := alertmanager.CommentIdentity(silence.Comment)
+			if !r.instance.Owns(&silence) || owned[identity] || !s.pastGracePeriod(silence) {
+				continue
+			}
+			orphans++
+
+			keys := []any{"id", silence.ID, "comment", identity, "target", target.Name, "tenant", target.Tenant}
+			if s.dryRun {
+				logger.Info("Found orphaned silence, not expiring it in dry-run mode", keys...)
+				continue
+			}
+			if err := r.silenceService.DeleteSilenceFromTarget(ctx, silence.Comment, silence.ID, target); err != nil {
+				errs = append(errs, errors.Wrapf(err, "failed to expire orphaned silence %s", silence.ID))
+				continue
+			}
+			metrics.OrphanedSilencesExpired.Inc()
+			logger.Info("Expired orphaned silence", keys...)
+		}
+	}
+	metrics.OrphanedSilences.Set(float64(orphans))
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// ownedSilences returns the comment identities, current and legacy, of all v1alpha1 and
+// v1alpha2 Silences, regardless of the selectors of this operator, and the tenants they
+// map to. The default tenant is always included.
+func (s *orphanSweeper) ownedSilences(ctx context.Context) (map[string]bool, map[string]bool, error) {
+	r := s.reconciler
+	owned := map[string]bool{}
+	tenants := map[string]bool{r.tenancyHelper.ExtractTenant(&metav1.ObjectMeta{}): true}
+
+	v1Silences := &v1alpha1.SilenceList{}
+	if err := r.client.List(ctx, v1Silences); err != nil && !meta.IsNoMatchError(err) {
+		return nil, nil, errors.Wrap(err, "failed to list v1alpha1 silences")
+	}
+	for i := range v1Silences.Items {
+		identity := alertmanager.ObjectIdentity(&v1Silences.Items[i])
+		identity.Instance = r.instance
+		owned[identity.String()] = true
+		owned[identity.Legacy()] = true
+		tenants[r.tenancyHelper.ExtractTenant(&v1Silences.Items[i])] = true
+	}
+
+	v2Silences := &v1alpha2.SilenceList{}
+	if err := r.client.List(ctx, v2Silences); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to list v1alpha2 silences")
+	}
+	for i := range v2Silences.Items {
+		silence := &v2Silences.Items[i]
+		identity := alertmanager.ObjectIdentity(silence)
+		identity.Instance = r.instance
+		owned[identity.String()] = true
+		owned[identity.Legacy()] = true
+		tenants[r.tenancyHelper.ExtractTenant(silence)] = true
+		if silence.Status.Tenant != "" {
+			tenants[silence.Status.Tenant] = true
+		}
+		for _, status := range silence.Status.Targets {
+			tenants[status.Tenant] = true
+		}
+	}
+
+	return owned, tenants, nil
+}
+
+// pastGracePeriod returns true when the silence was last updated more than the grace
+// period ago. Silences without an update time are aged by their start.
+func (s *orphanSweeper) pastGracePeriod(silence alertmanager.Silence) bool {
+	updatedAt := silence.UpdatedAt
+	if updatedAt.IsZero() {
+		updatedAt = silence.StartsAt
+	}
+	return time.Since(updatedAt) >= s.gracePeriod
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/giantswarm/silence-operator/api/v1alpha2"
+	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+)
+
+const (
+	// AdoptedFromAnnotation is set on v1alpha2 Silences created by the adoption and holds the
+	// ID of the Alertmanager silence they take over.
+	AdoptedFromAnnotation = "observability.giantswarm.io/adopted-from"
+
+	// adoptedNamePrefix prefixes the ID of the Alertmanager silence in the name of adopted
+	// Silences.
+	adoptedNamePrefix = "adopted-"
+
+	// Limits of the v1alpha2 Silence fields filled from the Alertmanager silence.
+	maxDescriptionLength = 2048
+	maxOwnerLength       = 256
+)
+
+// invalidNameChars matches the characters not allowed in Silence names.
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// silenceAdopter periodically creates v1alpha2 Silences for the silences created outside of
+// the operator in the default Alertmanager, e.g. in the Alertmanager UI. The Silences are
+// annotated with the ID of the Alertmanager silence, which the v1alpha2 controller takes
+// over instead of creating a new one. It runs on the leader only.
+//
+// A Silence is created at most once per Alertmanager silence, so deleting it before the
+// silence was taken over declines the adoption. Declined adoptions are remembered until the
+// operator restarts or another replica becomes the leader.
+type silenceAdopter struct {
+	reconciler *SilenceV2Reconciler
+	interval   time.Duration
+	// createdBy and comment select the silences to adopt. Nil matches any value.
+	createdBy *regexp.Regexp
+	comment   *regexp.Regexp
+	// namespaceLabel is the matcher label whose value is the namespace of the Silence.
+	namespaceLabel string
+	// defaultNamespace is used for silences without a matcher on namespaceLabel.
+	defaultNamespace string
+
+	// adopted holds the IDs of the selected silences a Silence was created for.
+	adopted map[string]struct{}
+}
+
+// Start adopts silences right away and then every interval until ctx is done.
+func (a *silenceAdopter) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("silence-adopter")
+	ctx = log.IntoContext(ctx, logger)
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := a.adopt(ctx); err != nil {
+			logger.Error(err, "Failed to adopt silences")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// adopt creates a Silence for each selected silence in the default Alertmanager that has
+// none yet and had none before. Every silence is attempted even if others fail.
+func (a *silenceAdopter) adopt(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+	r := a.reconciler
+
+	target := r.silenceService.DefaultTarget(r.tenancyHelper.ExtractTenant(&metav1.ObjectMeta{}))
+	silences, err := r.silenceService.ListSilences(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	adopted := map[string]struct{}{}
+	defer func() { a.adopted = adopted }()
+
+	var errs []error
+	for i := range silences {
+		if !a.selected(&silences[i]) {
+			continue
+		}
+		if _, ok := a.adopted[silences[i].ID]; ok {
+			// The Silence still exists and will take the silence over, or it was deleted
+			// before, which declines the adoption.
+			adopted[silences[i].ID] = struct{}{}
+			continue
+		}
+
+		silence, err := a.silenceFor(&silences[i])
+		if err != nil {
+			logger.Info("Not adopting silence", "id", silences[i].ID, "reason", err.Error())
+			continue
+		}
+
+		err = r.client.Create(ctx, silence)
+		if apierrors.IsAlreadyExists(err) {
+			// The Silence was created before the operator restarted and not reconciled yet.
+			adopted[silences[i].ID] = struct{}{}
+			continue
+		}
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to adopt silence %s", silences[i].ID))
+			continue
+		}
+		adopted[silences[i].ID] = struct{}{}
+
+		logger.Info("Adopted silence", "id", silences[i].ID, "namespace", silence.Namespace, "name", silence.Name)
+		r.recorder.Eventf(silence, nil, corev1.EventTypeNormal, "Adopted", "Adopt",
+			"Adopted Alertmanager silence %s created by %s", silences[i].ID, silences[i].CreatedBy)
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// selected reports whether the Alertmanager silence was created outside of every
+// installation of the operator and matches the creator and comment patterns.
+func (a *silenceAdopter) selected(silence *alertmanager.Silence) bool {
+	if alertmanager.CreatedByOperator(silence.CreatedBy) {
+		return false
+	}
+	if a.createdBy != nil && !a.createdBy.MatchString(silence.CreatedBy) {
+		return false
+	}
+	return a.comment == nil || a.comment.MatchString(silence.Comment)
+}
+
+// silenceFor builds the v1alpha2 Silence adopting an Alertmanager silence. The matchers and
+// time window are kept; the comment and creator become the description and owner.
+func (a *silenceAdopter) silenceFor(amSilence *alertmanager.Silence) (*v1alpha2.Silence, error) {
+	namespace := a.namespaceFor(amSilence)
+	if namespace == "" {
+		return nil, errors.Errorf("no matcher on %q and no default namespace", a.namespaceLabel)
+	}
+
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(amSilence.ID), "-"), "-")
+	if name == "" {
+		return nil, errors.Errorf("silence ID %q yields no valid name", amSilence.ID)
+	}
+
+	matchers := make([]v1alpha2.SilenceMatcher, 0, len(amSilence.Matchers))
+	for _, m := range amSilence.Matchers {
+		matchers = append(matchers, v1alpha2.SilenceMatcher{
+			Name:      m.Name,
+			Value:     m.Value,
+			MatchType: matchTypeOf(m.IsRegex, m.IsEqual),
+		})
+	}
+
+	return &v1alpha2.Silence{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        adoptedNamePrefix + name,
+			Namespace:   namespace,
+			Annotations: map[string]string{AdoptedFromAnnotation: amSilence.ID},
+		},
+		Spec: v1alpha2.SilenceSpec{
+			Matchers:    matchers,
+			StartsAt:    &metav1.Time{Time: amSilence.StartsAt},
+			EndsAt:      &metav1.Time{Time: amSilence.EndsAt},
+			Description: truncate(amSilence.Comment, maxDescriptionLength),
+			Owner:       truncate(amSilence.CreatedBy, maxOwnerLength),
+		},
+	}, nil
+}
+
+// namespaceFor returns the value of the equality matcher on the namespace label, or the
+// default namespace.
+func (a *silenceAdopter) namespaceFor(silence *alertmanager.Silence) string {
+	if a.namespaceLabel != "" {
+		for _, m := range silence.Matchers {
+			if m.Name == a.namespaceLabel && m.IsEqual && !m.IsRegex && m.Value != "" {
+				return m.Value
+			}
+		}
+	}
+	return a.defaultNamespace
+}
+
+// truncate shortens s to at most n bytes without splitting a UTF-8 character.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
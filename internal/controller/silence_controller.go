@@ -20,7 +20,9 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -30,6 +32,8 @@ import (
 	"github.com/giantswarm/silence-operator/api/v1alpha1"
 	"github.com/giantswarm/silence-operator/pkg/alertmanager"
 	"github.com/giantswarm/silence-operator/pkg/config"
+	"github.com/giantswarm/silence-operator/pkg/matcher"
+	"github.com/giantswarm/silence-operator/pkg/metrics"
 	"github.com/giantswarm/silence-operator/pkg/service"
 	"github.com/giantswarm/silence-operator/pkg/tenancy"
 )
@@ -40,23 +44,29 @@ const legacySilenceFinalizer = "operatorkit.giantswarm.io/silence-operator-silen
 
 // SilenceReconciler reconciles a Silence object
 type SilenceReconciler struct {
-	client client.Client
+	client   client.Client
+	recorder events.EventRecorder
+	// instance is the operator installation the Alertmanager silences are created for.
+	instance alertmanager.Instance
 
 	silenceService *service.SilenceService
 	tenancyHelper  *tenancy.Helper
 }
 
-// NewSilenceReconciler creates a new SilenceReconciler with the provided silence service and tenancy helper
-func NewSilenceReconciler(client client.Client, silenceService *service.SilenceService, tenancyHelper *tenancy.Helper) *SilenceReconciler {
+// NewSilenceReconciler creates a new SilenceReconciler with the provided event recorder, silence service and tenancy helper
+func NewSilenceReconciler(client client.Client, recorder events.EventRecorder, silenceService *service.SilenceService, tenancyHelper *tenancy.Helper) *SilenceReconciler {
 	return &SilenceReconciler{
 		client:         client,
+		recorder:       recorder,
 		silenceService: silenceService,
 		tenancyHelper:  tenancyHelper,
 	}
 }
 
 // +kubebuilder:rbac:groups=monitoring.giantswarm.io,resources=silences,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=monitoring.giantswarm.io,resources=silences/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=monitoring.giantswarm.io,resources=silences/finalizers,verbs=update
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -141,7 +151,7 @@ func (r *SilenceReconciler) cleanUpLegacyFinalizer(ctx context.Context, silence
 func (r *SilenceReconciler) reconcileCreate(ctx context.Context, silence *v1alpha1.Silence) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
-	newSilence, err := getSilenceFromCR(silence)
+	newSilence, err := getSilenceFromCR(silence, r.instance)
 	if err != nil {
 		return ctrl.Result{}, errors.WithStack(err)
 	}
@@ -151,16 +161,64 @@ func (r *SilenceReconciler) reconcileCreate(ctx context.Context, silence *v1alph
 
 	logger.Info("Syncing silence with Alertmanager", "tenant", tenant)
 
-	err = r.silenceService.SyncSilence(ctx, newSilence, tenant)
+	// v1alpha1 Silences do not record the ID of their Alertmanager silence, so the silence
+	// they had before identities included the UID is looked up until a sync recorded that
+	// the identity was migrated.
+	migrated := meta.IsStatusConditionTrue(silence.Status.Conditions, v1alpha1.ConditionIdentityMigrated)
+	if !migrated {
+		newSilence.ID, err = findLegacySilence(ctx, r.client, r.silenceService, silence, newSilence, tenant)
+		if err != nil {
+			return ctrl.Result{}, errors.WithStack(err)
+		}
+	}
+
+	result, err := r.silenceService.SyncSilence(ctx, newSilence, tenant)
 	if err != nil {
 		logger.Error(err, "Failed to sync silence with Alertmanager", "tenant", tenant)
+		if alertmanager.IsPermanent(err) {
+			// Retrying fails the same way; the silence is reconciled again once it changes.
+			recordSyncFailedEvent(r.recorder, silence, eventReasonRejected, err)
+			return ctrl.Result{}, nil
+		}
+		recordSyncFailedEvent(r.recorder, silence, eventReasonSyncFailed, err)
 		return ctrl.Result{}, errors.WithStack(err)
 	}
+	recordSyncEvent(r.recorder, silence, result, newSilence, "")
+	if result.Outcome == metrics.SyncOutcomeDeleted {
+		// v1alpha1 Silences have no status to tell when they expired, so only their expiry
+		// by the operator is recorded.
+		recordExpiredEvent(r.recorder, silence, newSilence.EndsAt)
+	}
+	logSyncChanges(ctx, result, "")
+
+	if !migrated {
+		if err := r.setIdentityMigrated(ctx, silence, newSilence.ID != ""); err != nil {
+			return ctrl.Result{}, errors.Wrap(err, "failed to update silence status")
+		}
+	}
 
 	logger.Info("Successfully synced silence with Alertmanager", "tenant", tenant)
 	return ctrl.Result{}, nil
 }
 
+// setIdentityMigrated records that the Alertmanager silence of silence is identified by its
+// UID, and whether the silence it had before was taken over.
+func (r *SilenceReconciler) setIdentityMigrated(ctx context.Context, silence *v1alpha1.Silence, legacySilenceMigrated bool) error {
+	original := silence.DeepCopy()
+	condition := metav1.Condition{
+		Type:    v1alpha1.ConditionIdentityMigrated,
+		Status:  metav1.ConditionTrue,
+		Reason:  v1alpha1.ReasonNoLegacySilence,
+		Message: "The Alertmanager silence is identified by the UID of the Silence",
+	}
+	if legacySilenceMigrated {
+		condition.Reason = v1alpha1.ReasonLegacySilenceMigrated
+		condition.Message = "The Alertmanager silence created before identities included the UID was taken over"
+	}
+	meta.SetStatusCondition(&silence.Status.Conditions, condition)
+	return errors.WithStack(r.client.Status().Patch(ctx, silence, client.MergeFrom(original)))
+}
+
 // reconcileDelete handles the deletion of the external Alertmanager silence.
 func (r *SilenceReconciler) reconcileDelete(ctx context.Context, silence *v1alpha1.Silence) error {
 	logger := log.FromContext(ctx)
@@ -170,31 +228,22 @@ func (r *SilenceReconciler) reconcileDelete(ctx context.Context, silence *v1alph
 
 	logger.Info("Deleting silence from Alertmanager as part of finalization", "tenant", tenant)
 
-	comment := alertmanager.SilenceComment(silence)
-	err := r.silenceService.DeleteSilence(ctx, comment, tenant)
+	comment := r.instance.SilenceComment(silence)
+	err := r.silenceService.DeleteSilence(ctx, comment, "", tenant)
 	if err != nil {
 		return errors.Wrap(err, "failed to delete silence from Alertmanager")
 	}
+	if err := deleteLegacySilences(ctx, r.client, r.silenceService, silence, comment, tenant); err != nil {
+		return errors.Wrap(err, "failed to delete legacy silences from Alertmanager")
+	}
 
 	logger.Info("Successfully deleted silence from Alertmanager", "tenant", tenant)
+	recordDeletedEvent(r.recorder, silence)
 	return nil
 }
 
-func getSilenceFromCR(silence *v1alpha1.Silence) (*alertmanager.Silence, error) {
-	var matchers []alertmanager.Matcher
-	for _, matcher := range silence.Spec.Matchers {
-		isEqual := true
-		if matcher.IsEqual != nil {
-			isEqual = *matcher.IsEqual
-		}
-		newMatcher := alertmanager.Matcher{
-			IsEqual: isEqual,
-			IsRegex: matcher.IsRegex,
-			Name:    matcher.Name,
-			Value:   matcher.Value,
-		}
-		matchers = append(matchers, newMatcher)
-	}
+func getSilenceFromCR(silence *v1alpha1.Silence, instance alertmanager.Instance) (*alertmanager.Silence, error) {
+	matchers := matcher.ConvertV1alpha1(silence.Spec.Matchers)
 
 	endsAt, err := alertmanager.SilenceEndsAt(silence)
 	if err != nil {
@@ -202,8 +251,8 @@ func getSilenceFromCR(silence *v1alpha1.Silence) (*alertmanager.Silence, error)
 	}
 
 	newSilence := &alertmanager.Silence{
-		Comment:   alertmanager.SilenceComment(silence),
-		CreatedBy: alertmanager.CreatedBy,
+		Comment:   instance.SilenceComment(silence),
+		CreatedBy: instance.CreatedBy(),
 		StartsAt:  silence.GetCreationTimestamp().Time,
 		EndsAt:    endsAt,
 		Matchers:  matchers,
@@ -214,6 +263,8 @@ func getSilenceFromCR(silence *v1alpha1.Silence) (*alertmanager.Silence, error)
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *SilenceReconciler) SetupWithManager(mgr ctrl.Manager, cfg config.Config) error {
+	r.instance = alertmanager.Instance(cfg.Instance)
+
 	controllerBuilder := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.Silence{}).
 		Named("silence")
@@ -23,13 +23,16 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
 
 	monitoringv1alpha1 "github.com/giantswarm/silence-operator/api/v1alpha1"
+	observabilityv1alpha2 "github.com/giantswarm/silence-operator/api/v1alpha2"
 	"github.com/giantswarm/silence-operator/internal/controller/testutils"
 	"github.com/giantswarm/silence-operator/pkg/alertmanager"
 	"github.com/giantswarm/silence-operator/pkg/config"
@@ -53,7 +56,7 @@ var _ = Describe("Silence Controller", func() {
 		Expect(err).NotTo(HaveOccurred())
 
 		// Create service and reconciler
-		silenceService := service.NewSilenceService(mockAlertmanager)
+		silenceService := service.NewSilenceService(mockAlertmanager, 0)
 
 		// Create tenancy helper with default config
 		cfg := config.Config{}
@@ -61,6 +64,7 @@ var _ = Describe("Silence Controller", func() {
 
 		reconciler = &SilenceReconciler{
 			client:         k8sClient,
+			recorder:       events.NewFakeRecorder(10),
 			silenceService: silenceService,
 			tenancyHelper:  tenancyHelper,
 		}
@@ -521,6 +525,117 @@ var _ = Describe("Silence Controller", func() {
 		})
 	})
 
+	Context("When reconciling a Silence with a legacy Alertmanager silence", func() {
+		It("should only migrate the legacy silence when no v1alpha2 Silence has the same legacy identity", func() {
+			newSilence := func(name string) *monitoringv1alpha1.Silence {
+				return &monitoringv1alpha1.Silence{
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+					Spec: monitoringv1alpha1.SilenceSpec{
+						Matchers: []monitoringv1alpha1.Matcher{{Name: testMatcherName, Value: testMatcherValue}},
+					},
+				}
+			}
+			addLegacySilence := func(silence *monitoringv1alpha1.Silence) {
+				mockServer.AddSilence(&alertmanager.Silence{
+					ID:        "legacy-" + silence.Name,
+					Comment:   alertmanager.ObjectIdentity(silence).Legacy(),
+					CreatedBy: alertmanager.CreatedBy,
+					StartsAt:  time.Now().Add(-time.Minute),
+					EndsAt:    time.Now().Add(time.Hour),
+					Matchers:  []alertmanager.Matcher{{Name: testMatcherName, Value: testMatcherValue, IsEqual: true}},
+					Status:    &alertmanager.Status{State: "active"},
+				})
+			}
+			reconcileSilence := func(silence *monitoringv1alpha1.Silence) *alertmanager.Silence {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: silence.Name}})
+				Expect(err).NotTo(HaveOccurred())
+				silences, err := mockAlertmanager.ListSilences(ctx, "")
+				Expect(err).NotTo(HaveOccurred())
+				for i := range silences {
+					if alertmanager.CommentIdentity(silences[i].Comment) == alertmanager.SilenceComment(silence) {
+						return &silences[i]
+					}
+				}
+				return nil
+			}
+			deleteSilence := func(silence *monitoringv1alpha1.Silence) {
+				Expect(k8sClient.Get(ctx, types.NamespacedName{Name: silence.Name}, silence)).To(Succeed())
+				controllerutil.RemoveFinalizer(silence, silenceFinalizer)
+				Expect(k8sClient.Update(ctx, silence)).To(Succeed())
+				Expect(k8sClient.Delete(ctx, silence)).To(Succeed())
+			}
+
+			By("migrating the legacy silence in place")
+			migrated := newSilence("default-legacy-migrated")
+			Expect(k8sClient.Create(ctx, migrated)).To(Succeed())
+			DeferCleanup(deleteSilence, migrated)
+			addLegacySilence(migrated)
+			got := reconcileSilence(migrated)
+			Expect(got).NotTo(BeNil())
+			Expect(got.ID).To(Equal("legacy-" + migrated.Name))
+
+			By("recording the migration, so that legacy silences are no longer looked up")
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: migrated.Name}, migrated)).To(Succeed())
+			condition := meta.FindStatusCondition(migrated.Status.Conditions, monitoringv1alpha1.ConditionIdentityMigrated)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(monitoringv1alpha1.ReasonLegacySilenceMigrated))
+
+			By("keeping the legacy silence of a v1alpha2 Silence with the same legacy identity")
+			claimed := newSilence("default-legacy-claimed-v1")
+			Expect(k8sClient.Create(ctx, claimed)).To(Succeed())
+			DeferCleanup(deleteSilence, claimed)
+			duration := observabilityv1alpha2.SilenceDuration("1h")
+			v2Silence := &observabilityv1alpha2.Silence{
+				ObjectMeta: metav1.ObjectMeta{Name: "legacy-claimed-v1", Namespace: defaultNamespace},
+				Spec: observabilityv1alpha2.SilenceSpec{
+					Duration: &duration,
+					Matchers: []observabilityv1alpha2.SilenceMatcher{
+						{Name: testMatcherName, Value: testMatcherValue, MatchType: observabilityv1alpha2.MatchEqual},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, v2Silence)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, v2Silence)).To(Succeed()) })
+			addLegacySilence(claimed)
+			got = reconcileSilence(claimed)
+			Expect(got).NotTo(BeNil())
+			Expect(got.ID).NotTo(Equal("legacy-" + claimed.Name))
+		})
+
+		It("should delete legacy silences with other matchers when the Silence is deleted", func() {
+			silence := &monitoringv1alpha1.Silence{
+				ObjectMeta: metav1.ObjectMeta{Name: "default-legacy-deleted"},
+				Spec: monitoringv1alpha1.SilenceSpec{
+					Matchers: []monitoringv1alpha1.Matcher{{Name: testMatcherName, Value: testMatcherValue}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+			mockServer.AddSilence(&alertmanager.Silence{
+				ID:        "legacy-" + silence.Name,
+				Comment:   alertmanager.ObjectIdentity(silence).Legacy(),
+				CreatedBy: alertmanager.CreatedBy,
+				StartsAt:  time.Now().Add(-time.Minute),
+				EndsAt:    time.Now().Add(time.Hour),
+				Matchers:  []alertmanager.Matcher{{Name: testMatcherName, Value: "other", IsEqual: true}},
+				Status:    &alertmanager.Status{State: "active"},
+			})
+
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: silence.Name}}
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockServer.GetSilences()).To(HaveLen(2))
+			Expect(k8sClient.Get(ctx, request.NamespacedName, silence)).To(Succeed())
+			Expect(meta.FindStatusCondition(silence.Status.Conditions, monitoringv1alpha1.ConditionIdentityMigrated).Reason).
+				To(Equal(monitoringv1alpha1.ReasonNoLegacySilence))
+
+			Expect(k8sClient.Delete(ctx, silence)).To(Succeed())
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockServer.GetSilences()).To(BeEmpty())
+		})
+	})
+
 	Context("When reconciling a non-existent Silence resource", func() {
 		It("should handle not found errors gracefully", func() {
 			By("reconciling a non-existent resource")
@@ -564,7 +679,7 @@ var _ = Describe("Silence Controller", func() {
 			}
 
 			By("converting CR to alertmanager Silence")
-			silence, err := getSilenceFromCR(cr)
+			silence, err := getSilenceFromCR(cr, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(silence).NotTo(BeNil())
 
@@ -0,0 +1,393 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/giantswarm/silence-operator/api/v1alpha1"
+	"github.com/giantswarm/silence-operator/api/v1alpha2"
+	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+	"github.com/giantswarm/silence-operator/pkg/config"
+)
+
+const (
+	// MigrateToNamespaceAnnotation opts a v1alpha1 Silence into the migration. Its value is the
+	// namespace of the v1alpha2 Silence; when empty the configured target namespace is used.
+	MigrateToNamespaceAnnotation = "monitoring.giantswarm.io/migrate-to-namespace"
+	// MigratedFromAnnotation is set on v1alpha2 Silences created by the migration and holds the
+	// name of the v1alpha1 source.
+	MigratedFromAnnotation = "observability.giantswarm.io/migrated-from"
+
+	reasonMigrationCompleted = "Completed"
+)
+
+// systemMetadataPrefixes lists the annotation and label prefixes owned by Kubernetes, GitOps
+// tooling and cloud providers. They are not copied to the migrated Silence.
+var systemMetadataPrefixes = []string{
+	"kubernetes.io",
+	"k8s.io",
+	"config.kubernetes.io",
+	"app.kubernetes.io",
+	"pod-template-hash",
+	"controller-revision-hash",
+	"fluxcd.io",
+	"helm.sh",
+	"kustomize.toolkit.fluxcd.io",
+	"source.toolkit.fluxcd.io",
+	"meta.helm.sh",
+	"kubectl.kubernetes.io",
+	"control-plane.alpha.kubernetes.io",
+	"node.alpha.kubernetes.io",
+	"volume.alpha.kubernetes.io",
+	"admission.gke.io",
+	"autopilot.gke.io",
+	"cloud.google.com",
+	"container.googleapis.com",
+}
+
+// SilenceMigrationReconciler migrates selected monitoring.giantswarm.io/v1alpha1 Silences to
+// observability.giantswarm.io/v1alpha2. The v1alpha1 Silence is only deleted once the v1alpha2
+// Silence is synced, so the alerts stay silenced during the handover.
+type SilenceMigrationReconciler struct {
+	client   client.Client
+	recorder events.EventRecorder
+
+	selector        labels.Selector
+	targetNamespace string
+}
+
+// NewSilenceMigrationReconciler creates a new SilenceMigrationReconciler. Silences carrying the
+// MigrateToNamespaceAnnotation or matching the selector are migrated into targetNamespace.
+func NewSilenceMigrationReconciler(client client.Client, recorder events.EventRecorder, selector labels.Selector, targetNamespace string) *SilenceMigrationReconciler {
+	return &SilenceMigrationReconciler{
+		client:          client,
+		recorder:        recorder,
+		selector:        selector,
+		targetNamespace: targetNamespace,
+	}
+}
+
+// +kubebuilder:rbac:groups=monitoring.giantswarm.io,resources=silences,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=monitoring.giantswarm.io,resources=silences/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=observability.giantswarm.io,resources=silences,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+
+// Reconcile creates or updates the v1alpha2 Silence for a selected v1alpha1 Silence and deletes
+// the v1alpha1 Silence once the v1alpha2 Silence has been synced with Alertmanager.
+func (r *SilenceMigrationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	silence := &v1alpha1.Silence{}
+	err := r.client.Get(ctx, req.NamespacedName, silence)
+	if err != nil {
+		return ctrl.Result{}, errors.WithStack(client.IgnoreNotFound(err))
+	}
+
+	if !silence.DeletionTimestamp.IsZero() || !r.selected(silence) {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("Started migrating silence")
+	defer logger.Info("Finished migrating silence")
+
+	original := silence.DeepCopy()
+
+	done, err := r.migrate(ctx, silence)
+	if statusErr := r.patchStatus(ctx, silence, original); statusErr != nil {
+		logger.Error(statusErr, "Failed to update silence status")
+		if err == nil {
+			err = statusErr
+		}
+	}
+	if err != nil || !done {
+		return ctrl.Result{}, err
+	}
+
+	// The v1alpha2 Silence is in Alertmanager: deleting the source lets the v1alpha1
+	// finalizer remove the legacy Alertmanager silence.
+	logger.Info("Deleting migrated silence")
+	err = r.client.Delete(ctx, silence, client.Preconditions{UID: &silence.UID})
+	if err != nil {
+		return ctrl.Result{}, errors.WithStack(client.IgnoreNotFound(err))
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// migrate converges the v1alpha2 Silence and records the progress in the Migrated condition.
+// It returns true once the v1alpha2 Silence is synced and the source can be deleted.
+func (r *SilenceMigrationReconciler) migrate(ctx context.Context, silence *v1alpha1.Silence) (bool, error) {
+	logger := log.FromContext(ctx)
+
+	targetNamespace := silence.GetAnnotations()[MigrateToNamespaceAnnotation]
+	if targetNamespace == "" {
+		targetNamespace = r.targetNamespace
+	}
+	if targetNamespace == "" {
+		r.setMigratedCondition(silence, nil, metav1.ConditionFalse, v1alpha1.ReasonMigrationInvalidSource,
+			fmt.Sprintf("No target namespace: set the %s annotation or the --migration-target-namespace flag", MigrateToNamespaceAnnotation))
+		return false, nil
+	}
+
+	desired, err := convertToV1alpha2(silence, targetNamespace)
+	if err != nil {
+		// The source has to be fixed, which triggers a new reconciliation.
+		r.setMigratedCondition(silence, nil, metav1.ConditionFalse, v1alpha1.ReasonMigrationInvalidSource, err.Error())
+		return false, nil
+	}
+
+	target := &v1alpha2.Silence{}
+	err = r.client.Get(ctx, client.ObjectKeyFromObject(desired), target)
+	if apierrors.IsNotFound(err) {
+		logger.Info("Creating v1alpha2 silence", "namespace", desired.Namespace, "name", desired.Name)
+		err := r.client.Create(ctx, desired)
+		if apierrors.IsNotFound(err) {
+			r.setMigratedCondition(silence, nil, metav1.ConditionFalse, v1alpha1.ReasonMigrationInvalidSource,
+				fmt.Sprintf("Target namespace %s does not exist", desired.Namespace))
+			return false, nil
+		} else if err != nil {
+			return false, errors.WithStack(err)
+		}
+		r.setMigratedCondition(silence, desired, metav1.ConditionFalse, v1alpha1.ReasonMigrationInProgress,
+			fmt.Sprintf("Created v1alpha2 Silence %s/%s, waiting for it to be synced with Alertmanager", desired.Namespace, desired.Name))
+		return false, nil
+	} else if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	if target.GetAnnotations()[MigratedFromAnnotation] != silence.Name {
+		r.setMigratedCondition(silence, target, metav1.ConditionFalse, v1alpha1.ReasonMigrationTargetConflict,
+			fmt.Sprintf("v1alpha2 Silence %s/%s already exists and was not created by the migration", target.Namespace, target.Name))
+		return false, nil
+	}
+
+	if !equality.Semantic.DeepEqual(target.Spec, desired.Spec) {
+		logger.Info("Updating v1alpha2 silence", "namespace", target.Namespace, "name", target.Name)
+		target.Spec = desired.Spec
+		if err := r.client.Update(ctx, target); err != nil {
+			return false, errors.WithStack(err)
+		}
+		r.setMigratedCondition(silence, target, metav1.ConditionFalse, v1alpha1.ReasonMigrationInProgress,
+			fmt.Sprintf("Updated v1alpha2 Silence %s/%s, waiting for it to be synced with Alertmanager", target.Namespace, target.Name))
+		return false, nil
+	}
+
+	synced := meta.FindStatusCondition(target.Status.Conditions, v1alpha2.ConditionSynced)
+	switch {
+	case synced == nil || synced.ObservedGeneration != target.Generation:
+		r.setMigratedCondition(silence, target, metav1.ConditionFalse, v1alpha1.ReasonMigrationInProgress,
+			fmt.Sprintf("Waiting for v1alpha2 Silence %s/%s to be synced with Alertmanager", target.Namespace, target.Name))
+		return false, nil
+	case synced.Status != metav1.ConditionTrue:
+		r.setMigratedCondition(silence, target, metav1.ConditionFalse, v1alpha1.ReasonMigrationTargetSyncFailed,
+			fmt.Sprintf("v1alpha2 Silence %s/%s failed to sync with Alertmanager: %s", target.Namespace, target.Name, synced.Message))
+		return false, nil
+	}
+
+	r.setMigratedCondition(silence, target, metav1.ConditionTrue, reasonMigrationCompleted,
+		fmt.Sprintf("Migrated to v1alpha2 Silence %s/%s", target.Namespace, target.Name))
+	r.recorder.Eventf(target, silence, corev1.EventTypeNormal, "Migrated", "Migrate",
+		"Migrated from monitoring.giantswarm.io/v1alpha1 Silence %s", silence.Name)
+	return true, nil
+}
+
+// setMigratedCondition sets the Migrated condition and emits an event for every transition.
+func (r *SilenceMigrationReconciler) setMigratedCondition(silence *v1alpha1.Silence, target *v1alpha2.Silence, status metav1.ConditionStatus, reason, message string) {
+	changed := meta.SetStatusCondition(&silence.Status.Conditions, metav1.Condition{
+		Type:               v1alpha1.ConditionMigrated,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: silence.Generation,
+	})
+	if !changed {
+		return
+	}
+
+	eventType := corev1.EventTypeNormal
+	switch reason {
+	case v1alpha1.ReasonMigrationInvalidSource, v1alpha1.ReasonMigrationTargetConflict, v1alpha1.ReasonMigrationTargetSyncFailed:
+		eventType = corev1.EventTypeWarning
+	}
+
+	// Pass an untyped nil while there is no v1alpha2 Silence to relate the event to.
+	var related client.Object
+	if target != nil {
+		related = target
+	}
+	r.recorder.Eventf(silence, related, eventType, "Migration"+reason, "Migrate", "%s", message)
+}
+
+func (r *SilenceMigrationReconciler) patchStatus(ctx context.Context, silence, original *v1alpha1.Silence) error {
+	if equality.Semantic.DeepEqual(original.Status, silence.Status) {
+		return nil
+	}
+	return errors.WithStack(r.client.Status().Patch(ctx, silence, client.MergeFrom(original)))
+}
+
+// selected reports whether the Silence opted into the migration.
+func (r *SilenceMigrationReconciler) selected(obj client.Object) bool {
+	if _, ok := obj.GetAnnotations()[MigrateToNamespaceAnnotation]; ok {
+		return true
+	}
+	return r.selector != nil && !r.selector.Empty() && r.selector.Matches(labels.Set(obj.GetLabels()))
+}
+
+// convertToV1alpha2 builds the v1alpha2 equivalent of a v1alpha1 Silence. The Alertmanager
+// silence keeps its time window: startsAt is the creation of the source and the valid-until
+// annotation becomes spec.endsAt. The owner and issue links are kept as spec metadata.
+func convertToV1alpha2(silence *v1alpha1.Silence, namespace string) (*v1alpha2.Silence, error) {
+	var matchers []v1alpha2.SilenceMatcher
+	for _, m := range silence.Spec.Matchers {
+		isEqual := true
+		if m.IsEqual != nil {
+			isEqual = *m.IsEqual
+		}
+
+		matchers = append(matchers, v1alpha2.SilenceMatcher{
+			Name:      m.Name,
+			Value:     m.Value,
+			MatchType: matchTypeOf(m.IsRegex, isEqual),
+		})
+	}
+
+	startsAt := silence.GetCreationTimestamp()
+	spec := v1alpha2.SilenceSpec{
+		Matchers: matchers,
+		StartsAt: &startsAt,
+		Owner:    silence.Spec.Owner,
+	}
+	if silence.Spec.IssueURL != "" {
+		spec.Links = append(spec.Links, v1alpha2.SilenceLink{Name: "Issue", URL: silence.Spec.IssueURL})
+	}
+	if silence.Spec.PostmortemURL != nil && *silence.Spec.PostmortemURL != "" {
+		spec.Links = append(spec.Links, v1alpha2.SilenceLink{Name: "Postmortem", URL: *silence.Spec.PostmortemURL})
+	}
+
+	if _, ok := silence.GetAnnotations()[alertmanager.ValidUntilAnnotationName]; ok {
+		endsAt, err := alertmanager.SilenceEndsAt(silence)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		spec.EndsAt = &metav1.Time{Time: endsAt}
+	}
+
+	annotations := userMetadata(silence.GetAnnotations(), MigrateToNamespaceAnnotation, alertmanager.ValidUntilAnnotationName)
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[MigratedFromAnnotation] = silence.Name
+
+	return &v1alpha2.Silence{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        silence.Name,
+			Namespace:   namespace,
+			Labels:      userMetadata(silence.GetLabels()),
+			Annotations: annotations,
+		},
+		Spec: spec,
+	}, nil
+}
+
+// matchTypeOf returns the v1alpha2 match type of an Alertmanager matcher.
+func matchTypeOf(isRegex, isEqual bool) v1alpha2.MatchType {
+	switch {
+	case isRegex && isEqual:
+		return v1alpha2.MatchRegexMatch
+	case isRegex:
+		return v1alpha2.MatchRegexNotMatch
+	case isEqual:
+		return v1alpha2.MatchEqual
+	default:
+		return v1alpha2.MatchNotEqual
+	}
+}
+
+// userMetadata returns the entries of an annotation or label map that are neither system
+// metadata nor one of the excluded keys.
+func userMetadata(metadata map[string]string, excluded ...string) map[string]string {
+	var result map[string]string
+	for key, value := range metadata {
+		if isSystemMetadata(key) || slices.Contains(excluded, key) {
+			continue
+		}
+		if result == nil {
+			result = map[string]string{}
+		}
+		result[key] = value
+	}
+	return result
+}
+
+func isSystemMetadata(key string) bool {
+	for _, prefix := range systemMetadataPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SilenceMigrationReconciler) SetupWithManager(mgr ctrl.Manager, cfg config.Config) error {
+	predicates := []predicate.Predicate{predicate.NewPredicateFuncs(r.selected)}
+
+	if cfg.SilenceSelector != nil && !cfg.SilenceSelector.Empty() {
+		metaLabelSelector, err := metav1.ParseToLabelSelector(cfg.SilenceSelector.String())
+		if err != nil {
+			return errors.Wrap(err, "failed to parse silence selector for predicate")
+		}
+		labelPredicate, err := predicate.LabelSelectorPredicate(*metaLabelSelector)
+		if err != nil {
+			return errors.Wrap(err, "failed to create label selector predicate")
+		}
+		predicates = append(predicates, labelPredicate)
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.Silence{}, builder.WithPredicates(predicates...)).
+		// Status changes of the v1alpha2 Silence complete the handover.
+		Watches(&v1alpha2.Silence{}, handler.EnqueueRequestsFromMapFunc(func(_ context.Context, obj client.Object) []reconcile.Request {
+			name, ok := obj.GetAnnotations()[MigratedFromAnnotation]
+			if !ok {
+				return nil
+			}
+			return []reconcile.Request{{NamespacedName: client.ObjectKey{Name: name}}}
+		})).
+		Named("silence-migration").
+		Complete(r)
+}
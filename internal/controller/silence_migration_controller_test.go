@@ -0,0 +1,196 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	monitoringv1alpha1 "github.com/giantswarm/silence-operator/api/v1alpha1"
+	observabilityv1alpha2 "github.com/giantswarm/silence-operator/api/v1alpha2"
+)
+
+var _ = Describe("Silence Migration Controller", func() {
+	var (
+		reconciler *SilenceMigrationReconciler
+		recorder   *events.FakeRecorder
+		ctx        context.Context
+	)
+
+	const resourceName = "test-silence-migration"
+	sourceName := types.NamespacedName{Name: resourceName}
+	targetName := types.NamespacedName{Name: resourceName, Namespace: defaultNamespace}
+
+	notEqual := false
+
+	newSource := func(annotations map[string]string) *monitoringv1alpha1.Silence {
+		return &monitoringv1alpha1.Silence{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        resourceName,
+				Annotations: annotations,
+				Labels: map[string]string{
+					testLabelTeam:                      testTeamPlatform,
+					"kustomize.toolkit.fluxcd.io/name": "silences",
+				},
+			},
+			Spec: monitoringv1alpha1.SilenceSpec{
+				Matchers: []monitoringv1alpha1.Matcher{
+					{Name: testMatcherName, Value: testMatcherValue},
+					{Name: "instance", Value: ".*prod.*", IsRegex: true},
+					{Name: "severity", Value: "info", IsEqual: &notEqual},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		recorder = events.NewFakeRecorder(10)
+		reconciler = NewSilenceMigrationReconciler(k8sClient, recorder, nil, defaultNamespace)
+	})
+
+	AfterEach(func() {
+		source := &monitoringv1alpha1.Silence{}
+		if err := k8sClient.Get(ctx, sourceName, source); err == nil {
+			Expect(k8sClient.Delete(ctx, source)).To(Succeed())
+		}
+		target := &observabilityv1alpha2.Silence{}
+		if err := k8sClient.Get(ctx, targetName, target); err == nil {
+			Expect(k8sClient.Delete(ctx, target)).To(Succeed())
+		}
+	})
+
+	It("converts a v1alpha1 Silence", func() {
+		source := newSource(map[string]string{
+			MigrateToNamespaceAnnotation:  "",
+			"valid-until":                 "2030-01-02",
+			"motivation":                  "maintenance",
+			"config.kubernetes.io/origin": "path: silences.yaml",
+		})
+		source.CreationTimestamp = metav1.NewTime(time.Date(2029, 12, 1, 0, 0, 0, 0, time.UTC))
+		source.Spec.Owner = "jdoe"
+		source.Spec.IssueURL = "https://github.com/giantswarm/giantswarm/issues/1"
+
+		target, err := convertToV1alpha2(source, "monitoring")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(target.Namespace).To(Equal("monitoring"))
+		Expect(target.Spec.Matchers).To(Equal([]observabilityv1alpha2.SilenceMatcher{
+			{Name: testMatcherName, Value: testMatcherValue, MatchType: observabilityv1alpha2.MatchEqual},
+			{Name: "instance", Value: ".*prod.*", MatchType: observabilityv1alpha2.MatchRegexMatch},
+			{Name: "severity", Value: "info", MatchType: observabilityv1alpha2.MatchNotEqual},
+		}))
+		Expect(target.Spec.StartsAt.Time).To(Equal(source.CreationTimestamp.Time))
+		Expect(target.Spec.EndsAt.Time).To(Equal(time.Date(2030, 1, 2, 8, 0, 0, 0, time.UTC)))
+		Expect(target.Spec.Owner).To(Equal("jdoe"))
+		Expect(target.Spec.Links).To(Equal([]observabilityv1alpha2.SilenceLink{
+			{Name: "Issue", URL: "https://github.com/giantswarm/giantswarm/issues/1"},
+		}))
+		Expect(target.Labels).To(Equal(map[string]string{testLabelTeam: testTeamPlatform}))
+		Expect(target.Annotations).To(Equal(map[string]string{
+			"motivation":           "maintenance",
+			MigratedFromAnnotation: resourceName,
+		}))
+	})
+
+	It("rejects an invalid valid-until annotation", func() {
+		_, err := convertToV1alpha2(newSource(map[string]string{"valid-until": "tomorrow"}), defaultNamespace)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("ignores Silences that did not opt in", func() {
+		Expect(k8sClient.Create(ctx, newSource(nil))).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: sourceName})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = k8sClient.Get(ctx, targetName, &observabilityv1alpha2.Silence{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("deletes the source only once the v1alpha2 Silence is synced", func() {
+		Expect(k8sClient.Create(ctx, newSource(map[string]string{MigrateToNamespaceAnnotation: ""}))).To(Succeed())
+
+		By("creating the v1alpha2 Silence")
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: sourceName})
+		Expect(err).NotTo(HaveOccurred())
+
+		target := &observabilityv1alpha2.Silence{}
+		Expect(k8sClient.Get(ctx, targetName, target)).To(Succeed())
+		Expect(target.Annotations).To(HaveKeyWithValue(MigratedFromAnnotation, resourceName))
+
+		source := &monitoringv1alpha1.Silence{}
+		Expect(k8sClient.Get(ctx, sourceName, source)).To(Succeed())
+		condition := meta.FindStatusCondition(source.Status.Conditions, monitoringv1alpha1.ConditionMigrated)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).To(Equal(monitoringv1alpha1.ReasonMigrationInProgress))
+		Expect(recorder.Events).To(Receive(ContainSubstring("Created v1alpha2 Silence")))
+
+		By("keeping the source while the v1alpha2 Silence is not synced")
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: sourceName})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(k8sClient.Get(ctx, sourceName, source)).To(Succeed())
+
+		By("deleting the source once the v1alpha2 Silence is synced")
+		meta.SetStatusCondition(&target.Status.Conditions, metav1.Condition{
+			Type:               observabilityv1alpha2.ConditionSynced,
+			Status:             metav1.ConditionTrue,
+			Reason:             observabilityv1alpha2.ReasonSynced,
+			Message:            "Silence is in sync with Alertmanager",
+			ObservedGeneration: target.Generation,
+		})
+		Expect(k8sClient.Status().Update(ctx, target)).To(Succeed())
+
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: sourceName})
+		Expect(err).NotTo(HaveOccurred())
+		err = k8sClient.Get(ctx, sourceName, source)
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("does not take over an existing v1alpha2 Silence", func() {
+		existing := &observabilityv1alpha2.Silence{
+			ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: defaultNamespace},
+			Spec: observabilityv1alpha2.SilenceSpec{
+				Matchers: []observabilityv1alpha2.SilenceMatcher{{Name: testMatcherName, Value: testMatcherValue}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, existing)).To(Succeed())
+		Expect(k8sClient.Create(ctx, newSource(map[string]string{MigrateToNamespaceAnnotation: defaultNamespace}))).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: sourceName})
+		Expect(err).NotTo(HaveOccurred())
+
+		source := &monitoringv1alpha1.Silence{}
+		Expect(k8sClient.Get(ctx, sourceName, source)).To(Succeed())
+		condition := meta.FindStatusCondition(source.Status.Conditions, monitoringv1alpha1.ConditionMigrated)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).To(Equal(monitoringv1alpha1.ReasonMigrationTargetConflict))
+
+		target := &observabilityv1alpha2.Silence{}
+		Expect(k8sClient.Get(ctx, targetName, target)).To(Succeed())
+		Expect(target.Spec.Matchers).To(HaveLen(1))
+	})
+})
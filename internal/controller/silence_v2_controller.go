@@ -21,18 +21,27 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/giantswarm/silence-operator/api/v1alpha2"
 	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+	"github.com/giantswarm/silence-operator/pkg/approval"
 	"github.com/giantswarm/silence-operator/pkg/config"
+	"github.com/giantswarm/silence-operator/pkg/matcher"
 	"github.com/giantswarm/silence-operator/pkg/service"
 	"github.com/giantswarm/silence-operator/pkg/tenancy"
 )
@@ -43,24 +52,57 @@ const (
 )
 
 // SilenceV2Reconciler reconciles a Silence object in the observability.giantswarm.io API group
-// +kubebuilder:rbac:groups=observability.giantswarm.io,resources=silences,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups=observability.giantswarm.io,resources=silences/finalizers,verbs=update
 type SilenceV2Reconciler struct {
-	client client.Client
+	client   client.Client
+	recorder events.EventRecorder
+	// apiReader reads bearer token Secrets of AlertmanagerTargets without caching them.
+	apiReader client.Reader
+	// secretNamespace is the only namespace bearer token Secrets are read from.
+	secretNamespace string
+	// instance is the operator installation the Alertmanager silences are created for.
+	instance alertmanager.Instance
+	// bearerToken is the operator's service account token, sent to AlertmanagerTargets
+	// that enable serviceAccountToken authentication.
+	bearerToken string
+	// requestTimeout and maxRetries configure the clients of AlertmanagerTargets like the
+	// client of the default Alertmanager.
+	requestTimeout time.Duration
+	maxRetries     int
 
 	silenceService *service.SilenceService
 	tenancyHelper  *tenancy.Helper
+	// ttlAfterExpiry is how long ended silences are kept when they do not set
+	// spec.ttlAfterExpiry. Zero keeps them.
+	ttlAfterExpiry time.Duration
+	// matchedAlertsRefreshInterval is how old the preview of the matched alerts may get
+	// before it is refreshed. Zero only refreshes it when the silence changes.
+	matchedAlertsRefreshInterval time.Duration
+	// approvalPolicy decides which silences are held back until they are approved.
+	// Approvals are disabled when it is nil.
+	approvalPolicy *approval.Policy
+
+	// predicates filter the silences enqueued for AlertmanagerTarget changes the same
+	// way events of the silences themselves are filtered.
+	predicates []predicate.Predicate
 }
 
-// NewSilenceV2Reconciler creates a new SilenceV2Reconciler with the provided silence service and tenancy helper
-func NewSilenceV2Reconciler(client client.Client, silenceService *service.SilenceService, tenancyHelper *tenancy.Helper) *SilenceV2Reconciler {
+// NewSilenceV2Reconciler creates a new SilenceV2Reconciler with the provided event recorder, silence service and tenancy helper
+func NewSilenceV2Reconciler(client client.Client, recorder events.EventRecorder, silenceService *service.SilenceService, tenancyHelper *tenancy.Helper) *SilenceV2Reconciler {
 	return &SilenceV2Reconciler{
 		client:         client,
+		recorder:       recorder,
 		silenceService: silenceService,
 		tenancyHelper:  tenancyHelper,
 	}
 }
 
+// +kubebuilder:rbac:groups=observability.giantswarm.io,resources=silences,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=observability.giantswarm.io,resources=silences/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=observability.giantswarm.io,resources=silences/finalizers,verbs=update
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=observability.giantswarm.io,resources=alertmanagertargets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",namespace=system,resources=secrets,verbs=get
+
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
 func (r *SilenceV2Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -113,25 +155,243 @@ func (r *SilenceV2Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 func (r *SilenceV2Reconciler) reconcileCreate(ctx context.Context, silence *v1alpha2.Silence) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
+	// Keep a copy of the object to compute the status patch against.
+	original := silence.DeepCopy()
+
+	// Extract tenant information from the silence resource
+	tenant := r.tenancyHelper.ExtractTenant(silence)
+
+	silence.Status.ObservedGeneration = silence.Generation
+	silence.Status.Tenant = tenant
+
 	// Convert the Kubernetes CR to alertmanager.Silence
 	alertmanagerSilence, err := r.getSilenceFromCR(silence)
+	var ttl time.Duration
+	var collect bool
+	if err == nil {
+		ttl, collect, err = r.expiryTTL(silence)
+	}
 	if err != nil {
+		setSyncFailedStatus(silence, v1alpha2.ReasonInvalidSpec, err)
+		if statusErr := r.patchStatus(ctx, silence, original); statusErr != nil {
+			logger.Error(statusErr, "Failed to update silence status")
+		}
 		return ctrl.Result{}, errors.WithStack(err)
 	}
 
-	// Extract tenant information from the silence resource
-	tenant := r.tenancyHelper.ExtractTenant(silence)
+	silence.Status.StartsAt = &metav1.Time{Time: alertmanagerSilence.StartsAt}
+	silence.Status.EndsAt = &metav1.Time{Time: alertmanagerSilence.EndsAt}
+
+	if risks := r.approvalPolicy.Risks(alertmanagerSilence); len(risks) > 0 && !approval.Approved(silence) {
+		logger.Info("Silence requires approval before it is synced with Alertmanager", "risks", risks)
+		setPendingApprovalStatus(silence, risks)
+		// The silence is reconciled again when the approval annotation is added.
+		return ctrl.Result{}, errors.WithStack(r.patchStatus(ctx, silence, original))
+	}
 
 	logger.Info("Syncing silence with Alertmanager", "tenant", tenant, "namespace", silence.Namespace, "name", silence.Name)
 
-	err = r.silenceService.SyncSilence(ctx, alertmanagerSilence, tenant)
+	silenceID, synced, err := r.sync(ctx, silence, alertmanagerSilence, tenant)
 	if err != nil {
+		reason := v1alpha2.ReasonSyncFailed
+		switch {
+		case errors.Is(err, errTargetNotFound):
+			reason = v1alpha2.ReasonTargetNotFound
+		case alertmanager.IsPermanent(err):
+			reason = v1alpha2.ReasonRejected
+		}
 		logger.Error(err, "Failed to sync silence with Alertmanager", "tenant", tenant)
+		recordSyncFailedEvent(r.recorder, silence, reason, err)
+		setSyncFailedStatus(silence, reason, err)
+		if statusErr := r.patchStatus(ctx, silence, original); statusErr != nil {
+			logger.Error(statusErr, "Failed to update silence status")
+		}
+		switch reason {
+		case v1alpha2.ReasonTargetNotFound:
+			// The silence is reconciled again once a matching AlertmanagerTarget is created.
+			return ctrl.Result{}, nil
+		case v1alpha2.ReasonRejected:
+			// Retrying fails the same way; the silence is reconciled again once it changes.
+			return ctrl.Result{}, nil
+		}
 		return ctrl.Result{}, err
 	}
 
+	now := time.Now()
+	wasExpired := meta.IsStatusConditionTrue(silence.Status.Conditions, v1alpha2.ConditionExpired)
+	setSyncedStatus(silence, silenceID, now)
+	if !wasExpired && meta.IsStatusConditionTrue(silence.Status.Conditions, v1alpha2.ConditionExpired) {
+		// Alertmanager expires silences on its own, so the expiry is recorded when the
+		// status observes it rather than when the operator expires the silence.
+		recordExpiredEvent(r.recorder, silence, silence.Status.EndsAt.Time)
+	}
+	if r.previewDue(silence, now) {
+		r.previewAlerts(ctx, silence, alertmanagerSilence.Matchers, synced, now)
+	}
+	if err := r.patchStatus(ctx, silence, original); err != nil {
+		return ctrl.Result{}, errors.WithStack(err)
+	}
+
 	logger.Info("Successfully synced silence with Alertmanager", "tenant", tenant)
-	return ctrl.Result{}, nil
+
+	// Requeue when the silence starts or ends so that the status flips and expired
+	// silences are removed from Alertmanager on time. Recurring silences move on to
+	// their next window once the current one ends.
+	requeueAfter, ok := nextBoundary(alertmanagerSilence.StartsAt, alertmanagerSilence.EndsAt, now)
+	if !ok && collect {
+		// Ended silences are deleted once their TTL has passed.
+		deleteAt := alertmanagerSilence.EndsAt.Add(ttl)
+		if !now.Before(deleteAt) {
+			return ctrl.Result{}, r.deleteExpired(ctx, silence, ttl)
+		}
+		requeueAfter, ok = deleteAt.Sub(now)+boundaryDelay, true
+	}
+	if !ok {
+		return ctrl.Result{}, nil
+	}
+	logger.Info("Requeueing at next silence boundary", "requeueAfter", requeueAfter)
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// sync syncs the silence to the default Alertmanager or to its AlertmanagerTargets. It
+// returns the ID of the silence in the default Alertmanager, if it was synced there, and
+// the targets the silence was synced to.
+func (r *SilenceV2Reconciler) sync(ctx context.Context, silence *v1alpha2.Silence, alertmanagerSilence *alertmanager.Silence, tenant string) (string, []service.Target, error) {
+	if !usesTargets(silence) {
+		// Remove the silence from the targets it was routed to before.
+		remaining, err := r.removeFromTargets(ctx, alertmanagerSilence.Comment, silence.Status.Targets, nil)
+		silence.Status.Targets = remaining
+		if err != nil {
+			return "", nil, err
+		}
+
+		desired := *alertmanagerSilence
+		desired.ID = silence.Status.SilenceID
+		if adoptedFrom := silence.Annotations[AdoptedFromAnnotation]; desired.ID == "" && adoptedFrom != "" {
+			id, err := r.silenceService.AdoptSilence(ctx, &desired, adoptedFrom, tenant)
+			if err != nil {
+				return "", nil, err
+			}
+			desired.ID = id
+		}
+		if desired.ID == "" {
+			id, err := findLegacySilence(ctx, r.client, r.silenceService, silence, &desired, tenant)
+			if err != nil {
+				return "", nil, err
+			}
+			desired.ID = id
+		}
+		result, err := r.silenceService.SyncSilence(ctx, &desired, tenant)
+		if err != nil {
+			return "", nil, err
+		}
+		recordSyncEvent(r.recorder, silence, result, &desired, "")
+		logSyncChanges(ctx, result, "")
+		return result.SilenceID, []service.Target{r.silenceService.DefaultTarget(tenant)}, nil
+	}
+
+	amTargets, err := r.resolveTargets(ctx, silence)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// A silence last synced to the default Alertmanager is moved to its targets.
+	if len(silence.Status.Targets) == 0 && meta.IsStatusConditionTrue(silence.Status.Conditions, v1alpha2.ConditionSynced) {
+		log.FromContext(ctx).Info("Deleting silence from the default Alertmanager as it is now routed to AlertmanagerTargets", "tenant", tenant)
+		if err := r.silenceService.DeleteSilence(ctx, alertmanagerSilence.Comment, silence.Status.SilenceID, tenant); err != nil {
+			return "", nil, errors.Wrap(err, "failed to delete silence from the default Alertmanager")
+		}
+	}
+
+	targets, err := r.syncTargets(ctx, silence, alertmanagerSilence, amTargets, tenant)
+	return "", targets, err
+}
+
+// previewDue returns true when the alerts matched by silence were not previewed for its
+// current generation, or longer than the refresh interval ago. Listing all alerts of an
+// Alertmanager is expensive, so resyncs of unchanged silences reuse the last preview.
+func (r *SilenceV2Reconciler) previewDue(silence *v1alpha2.Silence, now time.Time) bool {
+	preview := silence.Status.MatchedAlerts
+	switch {
+	case preview == nil, preview.ObservedGeneration != silence.Generation, preview.LastUpdateTime == nil:
+		return true
+	case r.matchedAlertsRefreshInterval > 0:
+		return !now.Before(preview.LastUpdateTime.Add(r.matchedAlertsRefreshInterval))
+	default:
+		return false
+	}
+}
+
+// previewAlerts records the alerts the matchers select in the given targets. The preview
+// is informational, so failures are logged and the previous preview is kept.
+func (r *SilenceV2Reconciler) previewAlerts(ctx context.Context, silence *v1alpha2.Silence, matchers []alertmanager.Matcher, targets []service.Target, now time.Time) {
+	var alerts []alertmanager.Alert
+	for _, target := range targets {
+		matching, err := r.silenceService.MatchingAlerts(ctx, matchers, target)
+		if err != nil {
+			log.FromContext(ctx).Error(err, "Failed to preview alerts matched by silence", "target", target.Name, "tenant", target.Tenant)
+			return
+		}
+		alerts = append(alerts, matching...)
+	}
+	setMatchedAlertsStatus(silence, alerts, now)
+}
+
+// boundaryDelay is added to requeues at time boundaries so the boundary has passed
+// when the reconciliation runs.
+const boundaryDelay = time.Second
+
+// nextBoundary returns how long to wait until the silence window next starts or ends.
+// It returns false once the window has ended.
+func nextBoundary(startsAt, endsAt, now time.Time) (time.Duration, bool) {
+	switch {
+	case now.Before(startsAt):
+		return startsAt.Sub(now) + boundaryDelay, true
+	case now.Before(endsAt):
+		return endsAt.Sub(now) + boundaryDelay, true
+	default:
+		return 0, false
+	}
+}
+
+// expiryTTL returns how long the silence is kept after it ended and whether it is
+// deleted at all. Recurring silences never end and are kept.
+func (r *SilenceV2Reconciler) expiryTTL(silence *v1alpha2.Silence) (time.Duration, bool, error) {
+	if silence.Spec.Schedule != nil {
+		return 0, false, nil
+	}
+	if silence.Spec.TTLAfterExpiry != nil {
+		ttl, err := silence.Spec.TTLAfterExpiry.Duration()
+		if err != nil {
+			return 0, false, errors.Wrap(err, "invalid ttlAfterExpiry")
+		}
+		return ttl, true, nil
+	}
+	return r.ttlAfterExpiry, r.ttlAfterExpiry > 0, nil
+}
+
+// deleteExpired deletes the Silence resource once its TTL after expiry has passed. The
+// deletion is conditional on the observed resource version so that a silence extended
+// in the meantime is kept. The finalizer removes it from Alertmanager.
+func (r *SilenceV2Reconciler) deleteExpired(ctx context.Context, silence *v1alpha2.Silence, ttl time.Duration) error {
+	log.FromContext(ctx).Info("Deleting silence that ended longer than its TTL ago", "endsAt", silence.Status.EndsAt, "ttlAfterExpiry", ttl)
+
+	err := r.client.Delete(ctx, silence, client.Preconditions{UID: &silence.UID, ResourceVersion: &silence.ResourceVersion})
+	if err != nil {
+		return errors.WithStack(client.IgnoreNotFound(err))
+	}
+
+	r.recorder.Eventf(silence, nil, corev1.EventTypeNormal, "ExpiredSilenceDeleted", "Delete",
+		"Deleted silence that ended at %s after its TTL of %s", silence.Status.EndsAt.UTC().Format(time.RFC3339), ttl)
+	return nil
+}
+
+// patchStatus writes the status of silence when it differs from original.
+func (r *SilenceV2Reconciler) patchStatus(ctx context.Context, silence, original *v1alpha2.Silence) error {
+	if equality.Semantic.DeepEqual(original.Status, silence.Status) {
+		return nil
+	}
+	return r.client.Status().Patch(ctx, silence, client.MergeFrom(original))
 }
 
 func (r *SilenceV2Reconciler) reconcileDelete(ctx context.Context, silence *v1alpha2.Silence) error {
@@ -142,19 +402,29 @@ func (r *SilenceV2Reconciler) reconcileDelete(ctx context.Context, silence *v1al
 
 	logger.Info("Deleting silence from Alertmanager as part of finalization", "tenant", tenant)
 
-	comment := alertmanager.SilenceComment(silence)
-	err := r.silenceService.DeleteSilence(ctx, comment, tenant)
-	if err != nil {
-		return errors.Wrap(err, "failed to delete silence from Alertmanager")
+	comment := r.instance.SilenceComment(silence)
+	if !usesTargets(silence) {
+		err := r.silenceService.DeleteSilence(ctx, comment, silence.Status.SilenceID, tenant)
+		if err != nil {
+			return errors.Wrap(err, "failed to delete silence from Alertmanager")
+		}
+		if err := deleteLegacySilences(ctx, r.client, r.silenceService, silence, comment, tenant); err != nil {
+			return errors.Wrap(err, "failed to delete legacy silences from Alertmanager")
+		}
+	}
+
+	if err := r.reconcileDeleteTargets(ctx, silence, comment, tenant); err != nil {
+		return errors.Wrap(err, "failed to delete silence from AlertmanagerTargets")
 	}
 
 	logger.Info("Successfully deleted silence from Alertmanager", "tenant", tenant)
+	recordDeletedEvent(r.recorder, silence)
 	return nil
 }
 
 // getSilenceFromCR converts a v1alpha2.Silence to alertmanager.Silence
 func (r *SilenceV2Reconciler) getSilenceFromCR(silence *v1alpha2.Silence) (*alertmanager.Silence, error) {
-	matchers, err := convertMatchers(silence.Spec.Matchers)
+	matchers, err := matcher.ConvertV1alpha2(silence.Spec.Matchers)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -165,8 +435,8 @@ func (r *SilenceV2Reconciler) getSilenceFromCR(silence *v1alpha2.Silence) (*aler
 	}
 
 	newSilence := &alertmanager.Silence{
-		Comment:   alertmanager.SilenceComment(silence),
-		CreatedBy: alertmanager.CreatedBy,
+		Comment:   alertmanager.FormatComment(r.instance.SilenceComment(silence), commentDetails(silence)),
+		CreatedBy: r.instance.CreatedBy(),
 		StartsAt:  startsAt,
 		EndsAt:    endsAt,
 		Matchers:  matchers,
@@ -175,50 +445,29 @@ func (r *SilenceV2Reconciler) getSilenceFromCR(silence *v1alpha2.Silence) (*aler
 	return newSilence, nil
 }
 
-func convertMatchers(silenceMatchers []v1alpha2.SilenceMatcher) ([]alertmanager.Matcher, error) {
-	var matchers []alertmanager.Matcher
-	for _, matcher := range silenceMatchers {
-		var isRegex, isEqual bool
-
-		matchType := matcher.MatchType
-		if matchType == "" {
-			matchType = v1alpha2.MatchEqual
-		}
-
-		switch matchType {
-		case v1alpha2.MatchEqual:
-			isRegex = false
-			isEqual = true
-		case v1alpha2.MatchNotEqual:
-			isRegex = false
-			isEqual = false
-		case v1alpha2.MatchRegexMatch:
-			isRegex = true
-			isEqual = true
-		case v1alpha2.MatchRegexNotMatch:
-			isRegex = true
-			isEqual = false
-		default:
-			return nil, errors.Errorf("unsupported match type: %s", matchType)
-		}
-
-		matchers = append(matchers, alertmanager.Matcher{
-			IsRegex: isRegex,
-			IsEqual: isEqual,
-			Name:    matcher.Name,
-			Value:   matcher.Value,
-		})
+// commentDetails returns the metadata of silence that is rendered into the Alertmanager comment.
+func commentDetails(silence *v1alpha2.Silence) alertmanager.CommentDetails {
+	details := alertmanager.CommentDetails{
+		Description: silence.Spec.Description,
+		Owner:       silence.Spec.Owner,
 	}
-
-	return matchers, nil
+	for _, link := range silence.Spec.Links {
+		details.Links = append(details.Links, alertmanager.CommentLink{Name: link.Name, URL: link.URL})
+	}
+	return details
 }
 
 // calculateSilenceTimes resolves start and end times using the following priority chain:
-//  1. spec.startsAt / spec.endsAt (explicit timestamps)
-//  2. spec.startsAt + spec.duration
-//  3. creationTimestamp + valid-until annotation (migration path from v1alpha1)
-//  4. creationTimestamp + 100-year default (v1alpha1 backward compatibility)
+//  1. spec.schedule (the current or next recurring window)
+//  2. spec.startsAt / spec.endsAt (explicit timestamps)
+//  3. spec.startsAt + spec.duration
+//  4. creationTimestamp + valid-until annotation (migration path from v1alpha1)
+//  5. creationTimestamp + 100-year default (v1alpha1 backward compatibility)
 func (r *SilenceV2Reconciler) calculateSilenceTimes(silence *v1alpha2.Silence) (startsAt, endsAt time.Time, err error) {
+	if silence.Spec.Schedule != nil {
+		return silence.Spec.Schedule.Window(time.Now())
+	}
+
 	if silence.Spec.StartsAt != nil {
 		startsAt = silence.Spec.StartsAt.Time
 	} else {
@@ -250,9 +499,16 @@ func (r *SilenceV2Reconciler) calculateSilenceTimes(silence *v1alpha2.Silence) (
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *SilenceV2Reconciler) SetupWithManager(mgr ctrl.Manager, cfg config.Config) error {
-	controllerBuilder := ctrl.NewControllerManagedBy(mgr).
-		For(&v1alpha2.Silence{}).
-		Named("silence-v2")
+	r.apiReader = mgr.GetAPIReader()
+	r.instance = alertmanager.Instance(cfg.Instance)
+	r.bearerToken = cfg.BearerToken
+	r.secretNamespace = cfg.SecretNamespace
+	r.requestTimeout = cfg.RequestTimeout
+	r.maxRetries = cfg.MaxRetries
+	r.approvalPolicy = approval.NewPolicy(cfg)
+	r.ttlAfterExpiry = cfg.TTLAfterExpiry
+	r.matchedAlertsRefreshInterval = cfg.MatchedAlertsRefreshInterval
+	r.predicates = nil
 
 	if cfg.SilenceSelector != nil && !cfg.SilenceSelector.Empty() {
 		// Convert labels.Selector to metav1.LabelSelector string representation
@@ -267,7 +523,7 @@ func (r *SilenceV2Reconciler) SetupWithManager(mgr ctrl.Manager, cfg config.Conf
 		if err != nil {
 			return errors.Wrap(err, "failed to create label selector predicate")
 		}
-		controllerBuilder = controllerBuilder.WithEventFilter(labelPredicate)
+		r.predicates = append(r.predicates, labelPredicate)
 	}
 
 	// Add namespace selector predicate if configured
@@ -293,8 +549,53 @@ func (r *SilenceV2Reconciler) SetupWithManager(mgr ctrl.Manager, cfg config.Conf
 			// Check if the namespace matches the selector
 			return cfg.NamespaceSelector.Matches(labels.Set(namespaceObj.Labels))
 		})
-		controllerBuilder = controllerBuilder.WithEventFilter(namespacePredicate)
+		r.predicates = append(r.predicates, namespacePredicate)
 	}
 
-	return controllerBuilder.Complete(r)
+	// Registering fails when the controller is set up again in the same process, e.g. in
+	// tests. The collector registered first is kept.
+	if err := ctrlmetrics.Registry.Register(&silenceCollector{reconciler: r}); err != nil {
+		if _, ok := err.(prometheus.AlreadyRegisteredError); !ok {
+			return errors.Wrap(err, "failed to register silence metrics")
+		}
+	}
+
+	if cfg.OrphanSweepInterval > 0 {
+		sweeper := &orphanSweeper{
+			reconciler:  r,
+			interval:    cfg.OrphanSweepInterval,
+			gracePeriod: cfg.OrphanGracePeriod,
+			dryRun:      cfg.OrphanSweepDryRun,
+		}
+		if err := mgr.Add(sweeper); err != nil {
+			return errors.Wrap(err, "failed to add orphan sweeper")
+		}
+	}
+
+	if cfg.AdoptionInterval > 0 {
+		adopter := &silenceAdopter{
+			reconciler:       r,
+			interval:         cfg.AdoptionInterval,
+			createdBy:        cfg.AdoptionCreatedBy,
+			comment:          cfg.AdoptionComment,
+			namespaceLabel:   cfg.AdoptionNamespaceLabel,
+			defaultNamespace: cfg.AdoptionDefaultNamespace,
+		}
+		if err := mgr.Add(adopter); err != nil {
+			return errors.Wrap(err, "failed to add silence adopter")
+		}
+	}
+
+	// The predicates only apply to silences. AlertmanagerTargets are cluster-scoped and
+	// carry their own labels.
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha2.Silence{}, builder.WithPredicates(r.predicates...)).
+		Watches(&v1alpha2.AlertmanagerTarget{}, &targetEventHandler{
+			EventHandler:   handler.EnqueueRequestsFromMapFunc(r.silencesForTarget),
+			silenceService: r.silenceService,
+		})
+	if cfg.DriftDetectionInterval > 0 {
+		b = b.WatchesRawSource(&driftSource{reconciler: r, interval: cfg.DriftDetectionInterval})
+	}
+	return b.Named("silence-v2").Complete(r)
 }
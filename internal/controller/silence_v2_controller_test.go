@@ -18,20 +18,28 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"regexp"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	monitoringv1alpha1 "github.com/giantswarm/silence-operator/api/v1alpha1"
 	observabilityv1alpha2 "github.com/giantswarm/silence-operator/api/v1alpha2"
 	"github.com/giantswarm/silence-operator/internal/controller/testutils"
 	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+	"github.com/giantswarm/silence-operator/pkg/approval"
 	"github.com/giantswarm/silence-operator/pkg/config"
 	"github.com/giantswarm/silence-operator/pkg/service"
 	"github.com/giantswarm/silence-operator/pkg/tenancy"
@@ -99,9 +107,10 @@ var _ = Describe("SilenceV2 Controller", func() {
 			cfg := config.Config{}
 			tenancyHelper := tenancy.NewHelper(cfg)
 
-			silenceService := service.NewSilenceService(alertManager)
+			silenceService := service.NewSilenceService(alertManager, 0)
 			controllerReconciler := NewSilenceV2Reconciler(
 				k8sClient,
+				events.NewFakeRecorder(10),
 				silenceService,
 				tenancyHelper,
 			)
@@ -110,8 +119,26 @@ var _ = Describe("SilenceV2 Controller", func() {
 				NamespacedName: typeNamespacedName,
 			})
 			Expect(reconcileErr).NotTo(HaveOccurred())
-			// TODO(user): Add more specific assertions depending on your controller's reconciliation logic.
-			// Example: If you expect a certain status condition after reconciliation, verify it here.
+
+			By("Verifying the status reflects the synced silence")
+			reconciled := &observabilityv1alpha2.Silence{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, reconciled)).To(Succeed())
+			Expect(reconciled.Status.ObservedGeneration).To(Equal(reconciled.Generation))
+			Expect(reconciled.Status.StartsAt).NotTo(BeNil())
+			Expect(reconciled.Status.EndsAt).NotTo(BeNil())
+			Expect(reconciled.Status.LastSyncError).To(BeEmpty())
+			Expect(meta.IsStatusConditionTrue(reconciled.Status.Conditions, observabilityv1alpha2.ConditionSynced)).To(BeTrue())
+			Expect(meta.IsStatusConditionTrue(reconciled.Status.Conditions, observabilityv1alpha2.ConditionReady)).To(BeTrue())
+			Expect(meta.IsStatusConditionFalse(reconciled.Status.Conditions, observabilityv1alpha2.ConditionScheduled)).To(BeTrue())
+			Expect(meta.IsStatusConditionFalse(reconciled.Status.Conditions, observabilityv1alpha2.ConditionExpired)).To(BeTrue())
+
+			By("Reconciling again to pick up the Alertmanager silence ID")
+			_, reconcileErr = controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(reconcileErr).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, reconciled)).To(Succeed())
+			Expect(reconciled.Status.SilenceID).NotTo(BeEmpty())
 		})
 
 		It("should handle deletion with finalizer", func() {
@@ -146,9 +173,10 @@ var _ = Describe("SilenceV2 Controller", func() {
 			cfg := config.Config{}
 			tenancyHelper := tenancy.NewHelper(cfg)
 
-			silenceService := service.NewSilenceService(alertManager)
+			silenceService := service.NewSilenceService(alertManager, 0)
 			controllerReconciler := NewSilenceV2Reconciler(
 				k8sClient,
+				events.NewFakeRecorder(10),
 				silenceService,
 				tenancyHelper,
 			)
@@ -279,10 +307,10 @@ var _ = Describe("SilenceV2 Controller", func() {
 	})
 })
 
-// findSilenceByComment returns the first alertmanager silence whose Comment matches, or nil.
+// findSilenceByComment returns the first alertmanager silence whose comment identity matches, or nil.
 func findSilenceByComment(silences []alertmanager.Silence, comment string) *alertmanager.Silence {
 	for i := range silences {
-		if silences[i].Comment == comment {
+		if alertmanager.CommentIdentity(silences[i].Comment) == comment {
 			return &silences[i]
 		}
 	}
@@ -305,9 +333,10 @@ var _ = Describe("SilenceV2 CRD Integration Tests", func() {
 		cfg := config.Config{}
 		tenancyHelper := tenancy.NewHelper(cfg)
 
-		silenceService := service.NewSilenceService(alertManager)
+		silenceService := service.NewSilenceService(alertManager, 0)
 		reconciler = NewSilenceV2Reconciler(
 			k8sClient,
+			events.NewFakeRecorder(10),
 			silenceService,
 			tenancyHelper,
 		)
@@ -322,7 +351,7 @@ var _ = Describe("SilenceV2 CRD Integration Tests", func() {
 	listSilences := func() []alertmanager.Silence {
 		am, err := mockServer.GetAlertmanager()
 		Expect(err).NotTo(HaveOccurred())
-		silences, err := am.ListSilences("")
+		silences, err := am.ListSilences(ctx, "")
 		Expect(err).NotTo(HaveOccurred())
 		return silences
 	}
@@ -463,6 +492,240 @@ var _ = Describe("SilenceV2 CRD Integration Tests", func() {
 			Expect(got.EndsAt).To(BeTemporally("~", validUntil, time.Second))
 		})
 
+		It("should report scheduled and expired silences in status", func() {
+			now := time.Now()
+			futureStart := metav1.NewTime(now.Add(2 * time.Hour))
+			futureEnd := metav1.NewTime(now.Add(3 * time.Hour))
+			pastStart := metav1.NewTime(now.Add(-3 * time.Hour))
+			pastEnd := metav1.NewTime(now.Add(-2 * time.Hour))
+
+			scheduled := &observabilityv1alpha2.Silence{
+				ObjectMeta: metav1.ObjectMeta{Name: "silence-status-scheduled", Namespace: defaultNamespace},
+				Spec: observabilityv1alpha2.SilenceSpec{
+					StartsAt: &futureStart,
+					EndsAt:   &futureEnd,
+					Matchers: []observabilityv1alpha2.SilenceMatcher{{Name: testMatcherName, Value: testMatcherValue}},
+				},
+			}
+			expired := &observabilityv1alpha2.Silence{
+				ObjectMeta: metav1.ObjectMeta{Name: "silence-status-expired", Namespace: defaultNamespace},
+				Spec: observabilityv1alpha2.SilenceSpec{
+					StartsAt: &pastStart,
+					EndsAt:   &pastEnd,
+					Matchers: []observabilityv1alpha2.SilenceMatcher{{Name: testMatcherName, Value: testMatcherValue}},
+				},
+			}
+
+			for _, silence := range []*observabilityv1alpha2.Silence{scheduled, expired} {
+				Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+				DeferCleanup(func() { Expect(k8sClient.Delete(ctx, silence)).To(Succeed()) })
+				doReconcile(silence.Name, silence.Namespace)
+			}
+
+			got := &observabilityv1alpha2.Silence{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: scheduled.Name, Namespace: scheduled.Namespace}, got)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(got.Status.Conditions, observabilityv1alpha2.ConditionScheduled)).To(BeTrue())
+			Expect(meta.FindStatusCondition(got.Status.Conditions, observabilityv1alpha2.ConditionReady).Reason).To(Equal(observabilityv1alpha2.ReasonPending))
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: expired.Name, Namespace: expired.Namespace}, got)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(got.Status.Conditions, observabilityv1alpha2.ConditionExpired)).To(BeTrue())
+			Expect(meta.FindStatusCondition(got.Status.Conditions, observabilityv1alpha2.ConditionReady).Reason).To(Equal(observabilityv1alpha2.ReasonExpired))
+		})
+
+		It("should sync the current schedule window and requeue at its end", func() {
+			silence := &observabilityv1alpha2.Silence{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "silence-schedule",
+					Namespace: defaultNamespace,
+				},
+				Spec: observabilityv1alpha2.SilenceSpec{
+					// Fires every minute and lasts one hour, so a window is always active.
+					Schedule: &observabilityv1alpha2.SilenceSchedule{
+						Cron:     "* * * * *",
+						TimeZone: "Europe/Berlin",
+						Duration: "1h",
+					},
+					Matchers: []observabilityv1alpha2.SilenceMatcher{
+						{Name: testMatcherName, Value: testMatcherValue, MatchType: observabilityv1alpha2.MatchEqual},
+					},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, silence)).To(Succeed()) })
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: silence.Name, Namespace: silence.Namespace},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			comment := alertmanager.SilenceComment(silence)
+			got := findSilenceByComment(listSilences(), comment)
+			Expect(got).NotTo(BeNil(), "silence %q not found in Alertmanager", comment)
+			Expect(got.EndsAt.Sub(got.StartsAt)).To(Equal(time.Hour))
+			Expect(got.StartsAt).To(BeTemporally("<=", time.Now()))
+			Expect(result.RequeueAfter).To(BeNumerically("~", time.Until(got.EndsAt), 5*time.Second))
+		})
+
+		It("should requeue at startsAt and then at endsAt of a fixed window", func() {
+			now := time.Now()
+			startsAt := metav1.NewTime(now.Add(30 * time.Minute))
+			endsAt := metav1.NewTime(now.Add(2 * time.Hour))
+
+			silence := &observabilityv1alpha2.Silence{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "silence-requeue-boundaries",
+					Namespace: defaultNamespace,
+				},
+				Spec: observabilityv1alpha2.SilenceSpec{
+					StartsAt: &startsAt,
+					EndsAt:   &endsAt,
+					Matchers: []observabilityv1alpha2.SilenceMatcher{
+						{Name: testMatcherName, Value: testMatcherValue, MatchType: observabilityv1alpha2.MatchEqual},
+					},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, silence)).To(Succeed()) })
+
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: silence.Name, Namespace: silence.Namespace}}
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically("~", time.Until(startsAt.Time), 5*time.Second))
+
+			By("Moving the window start into the past")
+			updated := &observabilityv1alpha2.Silence{}
+			Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).To(Succeed())
+			pastStart := metav1.NewTime(now.Add(-time.Minute))
+			updated.Spec.StartsAt = &pastStart
+			Expect(k8sClient.Update(ctx, updated)).To(Succeed())
+
+			result, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically("~", time.Until(endsAt.Time), 5*time.Second))
+		})
+
+		It("should not requeue once the silence has ended", func() {
+			now := time.Now()
+			startsAt := metav1.NewTime(now.Add(-2 * time.Hour))
+			endsAt := metav1.NewTime(now.Add(-time.Hour))
+
+			silence := &observabilityv1alpha2.Silence{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "silence-requeue-ended",
+					Namespace: defaultNamespace,
+				},
+				Spec: observabilityv1alpha2.SilenceSpec{
+					StartsAt: &startsAt,
+					EndsAt:   &endsAt,
+					Matchers: []observabilityv1alpha2.SilenceMatcher{
+						{Name: testMatcherName, Value: testMatcherValue, MatchType: observabilityv1alpha2.MatchEqual},
+					},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, silence)).To(Succeed()) })
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: silence.Name, Namespace: silence.Namespace},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeZero())
+		})
+
+		It("should requeue ended silences until their TTL after expiry has passed", func() {
+			now := time.Now()
+			startsAt := metav1.NewTime(now.Add(-2 * time.Hour))
+			endsAt := metav1.NewTime(now.Add(-time.Hour))
+			ttl := observabilityv1alpha2.SilenceDuration("2h")
+
+			silence := &observabilityv1alpha2.Silence{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "silence-ttl-pending",
+					Namespace: defaultNamespace,
+				},
+				Spec: observabilityv1alpha2.SilenceSpec{
+					StartsAt:       &startsAt,
+					EndsAt:         &endsAt,
+					TTLAfterExpiry: &ttl,
+					Matchers: []observabilityv1alpha2.SilenceMatcher{
+						{Name: testMatcherName, Value: testMatcherValue, MatchType: observabilityv1alpha2.MatchEqual},
+					},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, silence)).To(Succeed()) })
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: silence.Name, Namespace: silence.Namespace},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically("~", time.Hour, 5*time.Second))
+		})
+
+		It("should delete ended silences once their TTL after expiry has passed", func() {
+			recorder := events.NewFakeRecorder(10)
+			reconciler.recorder = recorder
+
+			now := time.Now()
+			startsAt := metav1.NewTime(now.Add(-3 * time.Hour))
+			endsAt := metav1.NewTime(now.Add(-2 * time.Hour))
+			ttl := observabilityv1alpha2.SilenceDuration("1h")
+
+			silence := &observabilityv1alpha2.Silence{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "silence-ttl-expired",
+					Namespace: defaultNamespace,
+				},
+				Spec: observabilityv1alpha2.SilenceSpec{
+					StartsAt:       &startsAt,
+					EndsAt:         &endsAt,
+					TTLAfterExpiry: &ttl,
+					Matchers: []observabilityv1alpha2.SilenceMatcher{
+						{Name: testMatcherName, Value: testMatcherValue, MatchType: observabilityv1alpha2.MatchEqual},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+
+			By("deleting the silence and recording an event")
+			doReconcile(silence.Name, silence.Namespace)
+			Expect(recorder.Events).To(Receive(ContainSubstring("ExpiredSilenceDeleted")))
+
+			By("removing the finalizer")
+			doReconcile(silence.Name, silence.Namespace)
+			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(silence), &observabilityv1alpha2.Silence{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should reject schedule combined with explicit times", func() {
+			endsAt := metav1.NewTime(time.Now().Add(2 * time.Hour))
+
+			silence := &observabilityv1alpha2.Silence{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "invalid-silence-schedule",
+					Namespace: defaultNamespace,
+				},
+				Spec: observabilityv1alpha2.SilenceSpec{
+					EndsAt: &endsAt,
+					Schedule: &observabilityv1alpha2.SilenceSchedule{
+						Cron:     "0 2 * * *",
+						Duration: "4h",
+					},
+					Matchers: []observabilityv1alpha2.SilenceMatcher{
+						{Name: testMatcherName, Value: testMatcherValue, MatchType: observabilityv1alpha2.MatchEqual},
+					},
+				},
+			}
+
+			err := k8sClient.Create(ctx, silence)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("schedule is mutually exclusive"))
+		})
+
 		It("should reject endsAt and duration set simultaneously", func() {
 			now := time.Now()
 			endsAt := metav1.NewTime(now.Add(2 * time.Hour))
@@ -552,6 +815,886 @@ var _ = Describe("SilenceV2 CRD Integration Tests", func() {
 		})
 	})
 
+	Context("Silence IDs", func() {
+		It("should look up the synced silence by the ID recorded in the status", func() {
+			duration := observabilityv1alpha2.SilenceDuration("1h")
+			silence := &observabilityv1alpha2.Silence{
+				ObjectMeta: metav1.ObjectMeta{Name: "silence-id-lookup", Namespace: "default"},
+				Spec: observabilityv1alpha2.SilenceSpec{
+					Duration: &duration,
+					Matchers: []observabilityv1alpha2.SilenceMatcher{
+						{Name: testMatcherName, Value: testMatcherValue, MatchType: observabilityv1alpha2.MatchEqual},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, silence)).To(Succeed()) })
+
+			doReconcile(silence.Name, silence.Namespace)
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(silence), silence)).To(Succeed())
+			Expect(silence.Status.SilenceID).To(Equal(testutils.MockSilenceID(alertmanager.SilenceComment(silence))))
+
+			By("reconciling again without listing silences")
+			requests := mockServer.ListSilencesRequests()
+			doReconcile(silence.Name, silence.Namespace)
+			Expect(mockServer.ListSilencesRequests()).To(Equal(requests))
+
+			By("finding the silence by comment after its matchers changed")
+			silence.Status.SilenceID = ""
+			Expect(k8sClient.Status().Update(ctx, silence)).To(Succeed())
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(silence), silence)).To(Succeed())
+			silence.Spec.Matchers[0].Value = "changed"
+			Expect(k8sClient.Update(ctx, silence)).To(Succeed())
+
+			doReconcile(silence.Name, silence.Namespace)
+
+			silences := listSilences()
+			Expect(silences).To(HaveLen(1))
+			Expect(silences[0].Matchers[0].Value).To(Equal("changed"))
+		})
+	})
+
+	Context("Silence identity", func() {
+		It("should migrate silences with a legacy identity in place", func() {
+			duration := observabilityv1alpha2.SilenceDuration("1h")
+			silence := &observabilityv1alpha2.Silence{
+				ObjectMeta: metav1.ObjectMeta{Name: "silence-legacy-identity", Namespace: "default"},
+				Spec: observabilityv1alpha2.SilenceSpec{
+					Duration: &duration,
+					Matchers: []observabilityv1alpha2.SilenceMatcher{
+						{Name: testMatcherName, Value: testMatcherValue, MatchType: observabilityv1alpha2.MatchEqual},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, silence)).To(Succeed()) })
+
+			identity := alertmanager.ObjectIdentity(silence)
+			mockServer.AddSilence(&alertmanager.Silence{
+				ID:        "legacy",
+				Comment:   identity.Legacy(),
+				CreatedBy: alertmanager.CreatedBy,
+				StartsAt:  time.Now().Add(-time.Minute),
+				EndsAt:    time.Now().Add(time.Hour),
+				Matchers:  []alertmanager.Matcher{{Name: testMatcherName, Value: testMatcherValue, IsEqual: true}},
+				Status:    &alertmanager.Status{State: "active"},
+			})
+
+			doReconcile(silence.Name, silence.Namespace)
+
+			silences := listSilences()
+			Expect(silences).To(HaveLen(1))
+			Expect(silences[0].ID).To(Equal("legacy"))
+			Expect(alertmanager.CommentIdentity(silences[0].Comment)).To(Equal(identity.String()))
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(silence), silence)).To(Succeed())
+			Expect(silence.Status.SilenceID).To(Equal("legacy"))
+		})
+
+		It("should not migrate legacy silences that may belong to another resource", func() {
+			duration := observabilityv1alpha2.SilenceDuration("1h")
+			newSilence := func(name string) *observabilityv1alpha2.Silence {
+				return &observabilityv1alpha2.Silence{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+					Spec: observabilityv1alpha2.SilenceSpec{
+						Duration: &duration,
+						Matchers: []observabilityv1alpha2.SilenceMatcher{
+							{Name: testMatcherName, Value: testMatcherValue, MatchType: observabilityv1alpha2.MatchEqual},
+						},
+					},
+				}
+			}
+			addLegacySilence := func(silence *observabilityv1alpha2.Silence, matchers ...alertmanager.Matcher) {
+				mockServer.AddSilence(&alertmanager.Silence{
+					ID:        "legacy-" + silence.Name,
+					Comment:   alertmanager.ObjectIdentity(silence).Legacy(),
+					CreatedBy: alertmanager.CreatedBy,
+					StartsAt:  time.Now().Add(-time.Minute),
+					EndsAt:    time.Now().Add(time.Hour),
+					Matchers:  matchers,
+					Status:    &alertmanager.Status{State: "active"},
+				})
+			}
+
+			By("keeping the legacy silence of a v1alpha1 Silence with the same legacy identity")
+			claimed := newSilence("legacy-claimed")
+			Expect(k8sClient.Create(ctx, claimed)).To(Succeed())
+			DeferCleanup(func() { Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, claimed))).To(Succeed()) })
+			v1Silence := &monitoringv1alpha1.Silence{
+				ObjectMeta: metav1.ObjectMeta{Name: "default-legacy-claimed"},
+				Spec: monitoringv1alpha1.SilenceSpec{
+					Matchers: []monitoringv1alpha1.Matcher{{Name: testMatcherName, Value: testMatcherValue}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, v1Silence)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, v1Silence)).To(Succeed()) })
+			addLegacySilence(claimed, alertmanager.Matcher{Name: testMatcherName, Value: testMatcherValue, IsEqual: true})
+
+			doReconcile(claimed.Name, claimed.Namespace)
+			got := findSilenceByComment(listSilences(), alertmanager.SilenceComment(claimed))
+			Expect(got).NotTo(BeNil())
+			Expect(got.ID).NotTo(Equal("legacy-" + claimed.Name))
+			Expect(findSilenceByComment(listSilences(), alertmanager.ObjectIdentity(claimed).Legacy())).NotTo(BeNil())
+
+			By("keeping a legacy silence with other matchers")
+			other := newSilence("legacy-other-matchers")
+			Expect(k8sClient.Create(ctx, other)).To(Succeed())
+			DeferCleanup(func() { Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, other))).To(Succeed()) })
+			addLegacySilence(other, alertmanager.Matcher{Name: testMatcherName, Value: "other", IsEqual: true})
+
+			doReconcile(other.Name, other.Namespace)
+			got = findSilenceByComment(listSilences(), alertmanager.SilenceComment(other))
+			Expect(got).NotTo(BeNil())
+			Expect(got.ID).NotTo(Equal("legacy-" + other.Name))
+			Expect(findSilenceByComment(listSilences(), alertmanager.ObjectIdentity(other).Legacy())).NotTo(BeNil())
+
+			By("deleting the legacy silence with other matchers together with the Silence")
+			Expect(k8sClient.Delete(ctx, other)).To(Succeed())
+			doReconcile(other.Name, other.Namespace)
+			Expect(findSilenceByComment(listSilences(), alertmanager.SilenceComment(other))).To(BeNil())
+			Expect(findSilenceByComment(listSilences(), alertmanager.ObjectIdentity(other).Legacy())).To(BeNil())
+
+			By("keeping the legacy silence of the v1alpha1 Silence when the Silence is deleted")
+			Expect(k8sClient.Delete(ctx, claimed)).To(Succeed())
+			doReconcile(claimed.Name, claimed.Namespace)
+			Expect(findSilenceByComment(listSilences(), alertmanager.ObjectIdentity(claimed).Legacy())).NotTo(BeNil())
+		})
+
+		It("should not take over the silence of a deleted resource with the same name", func() {
+			duration := observabilityv1alpha2.SilenceDuration("1h")
+			newSilence := func() *observabilityv1alpha2.Silence {
+				return &observabilityv1alpha2.Silence{
+					ObjectMeta: metav1.ObjectMeta{Name: "silence-recreated", Namespace: "default"},
+					Spec: observabilityv1alpha2.SilenceSpec{
+						Duration: &duration,
+						Matchers: []observabilityv1alpha2.SilenceMatcher{
+							{Name: testMatcherName, Value: testMatcherValue, MatchType: observabilityv1alpha2.MatchEqual},
+						},
+					},
+				}
+			}
+
+			silence := newSilence()
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+			doReconcile(silence.Name, silence.Namespace)
+			previous := findSilenceByComment(listSilences(), alertmanager.SilenceComment(silence))
+			Expect(previous).NotTo(BeNil())
+
+			By("deleting the resource while its silence is left behind in Alertmanager")
+			Expect(k8sClient.Delete(ctx, silence)).To(Succeed())
+			doReconcile(silence.Name, silence.Namespace)
+			Expect(errors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(silence), silence))).To(BeTrue())
+			mockServer.AddSilence(previous)
+
+			By("creating a resource with the same name")
+			recreated := newSilence()
+			Expect(k8sClient.Create(ctx, recreated)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, recreated)).To(Succeed()) })
+			doReconcile(recreated.Name, recreated.Namespace)
+
+			got := findSilenceByComment(listSilences(), alertmanager.SilenceComment(recreated))
+			Expect(got).NotTo(BeNil())
+			Expect(got.ID).NotTo(Equal(previous.ID))
+			Expect(findSilenceByComment(listSilences(), previous.Comment)).NotTo(BeNil(), "the previous silence is left to the orphan sweeper")
+		})
+	})
+
+	Context("Drift detection", func() {
+		It("should enqueue silences whose Alertmanager silence is missing or changed", func() {
+			duration := observabilityv1alpha2.SilenceDuration("1h")
+			silence := &observabilityv1alpha2.Silence{
+				ObjectMeta: metav1.ObjectMeta{Name: "silence-drift", Namespace: "default"},
+				Spec: observabilityv1alpha2.SilenceSpec{
+					Duration: &duration,
+					Matchers: []observabilityv1alpha2.SilenceMatcher{
+						{Name: testMatcherName, Value: testMatcherValue, MatchType: observabilityv1alpha2.MatchEqual},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, silence)).To(Succeed()) })
+
+			doReconcile(silence.Name, silence.Namespace)
+
+			request := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(silence)}
+			Expect(reconciler.detectDrift(ctx)).NotTo(ContainElement(request))
+
+			By("detecting a silence changed in Alertmanager")
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(silence), silence)).To(Succeed())
+			got := findSilenceByComment(listSilences(), alertmanager.SilenceComment(silence))
+			Expect(got).NotTo(BeNil())
+			got.Matchers[0].Value = "edited"
+			mockServer.AddSilence(got)
+			Expect(reconciler.detectDrift(ctx)).To(ContainElement(request))
+
+			By("detecting a silence deleted from Alertmanager")
+			mockServer.RemoveSilence(got.ID)
+			Expect(reconciler.detectDrift(ctx)).To(ContainElement(request))
+
+			By("recreating the silence on the next reconciliation")
+			doReconcile(silence.Name, silence.Namespace)
+			Expect(findSilenceByComment(listSilences(), alertmanager.SilenceComment(silence))).NotTo(BeNil())
+			Expect(reconciler.detectDrift(ctx)).NotTo(ContainElement(request))
+		})
+	})
+
+	Context("Orphan sweeper", func() {
+		It("should expire operator-created silences without a Silence after the grace period", func() {
+			duration := observabilityv1alpha2.SilenceDuration("1h")
+			silence := &observabilityv1alpha2.Silence{
+				ObjectMeta: metav1.ObjectMeta{Name: "silence-owned", Namespace: "default"},
+				Spec: observabilityv1alpha2.SilenceSpec{
+					Duration: &duration,
+					Matchers: []observabilityv1alpha2.SilenceMatcher{
+						{Name: testMatcherName, Value: testMatcherValue, MatchType: observabilityv1alpha2.MatchEqual},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, silence)).To(Succeed()) })
+			doReconcile(silence.Name, silence.Namespace)
+
+			matchers := []alertmanager.Matcher{{Name: testMatcherName, Value: testMatcherValue, IsEqual: true}}
+			mockServer.AddSilence(&alertmanager.Silence{
+				ID: "orphan", Comment: "silence-operator-default-deleted", CreatedBy: alertmanager.CreatedBy,
+				Matchers: matchers, StartsAt: time.Now().Add(-2 * time.Hour), EndsAt: time.Now().Add(time.Hour),
+				Status: &alertmanager.Status{State: "active"},
+			})
+			mockServer.AddSilence(&alertmanager.Silence{
+				ID: "recent-orphan", Comment: "silence-operator-default-new", CreatedBy: alertmanager.CreatedBy,
+				Matchers: matchers, StartsAt: time.Now(), EndsAt: time.Now().Add(time.Hour),
+				Status: &alertmanager.Status{State: "active"},
+			})
+			mockServer.AddSilence(&alertmanager.Silence{
+				ID: "manual", Comment: "maintenance", CreatedBy: "someone",
+				Matchers: matchers, StartsAt: time.Now().Add(-2 * time.Hour), EndsAt: time.Now().Add(time.Hour),
+				Status: &alertmanager.Status{State: "active"},
+			})
+
+			sweeper := &orphanSweeper{reconciler: reconciler, gracePeriod: time.Hour, dryRun: true}
+
+			By("only reporting orphaned silences in dry-run mode")
+			Expect(sweeper.sweep(ctx)).To(Succeed())
+			Expect(listSilences()).To(HaveLen(4))
+
+			By("expiring orphaned silences past the grace period")
+			sweeper.dryRun = false
+			Expect(sweeper.sweep(ctx)).To(Succeed())
+			var ids []string
+			for _, s := range listSilences() {
+				ids = append(ids, s.ID)
+			}
+			Expect(ids).To(ConsistOf(testutils.MockSilenceID(alertmanager.SilenceComment(silence)), "recent-orphan", "manual"))
+		})
+	})
+
+	Context("Operator instances", func() {
+		It("should only touch the silences of its own instance", func() {
+			reconciler.instance = "workload"
+
+			duration := observabilityv1alpha2.SilenceDuration("1h")
+			silence := &observabilityv1alpha2.Silence{
+				ObjectMeta: metav1.ObjectMeta{Name: "silence-instance", Namespace: "default"},
+				Spec: observabilityv1alpha2.SilenceSpec{
+					Duration: &duration,
+					Matchers: []observabilityv1alpha2.SilenceMatcher{
+						{Name: testMatcherName, Value: testMatcherValue, MatchType: observabilityv1alpha2.MatchEqual},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+
+			identity := alertmanager.ObjectIdentity(silence)
+			other := identity
+			other.Instance = "management"
+			orphan := identity
+			orphan.Instance = reconciler.instance
+			orphan.Name = "deleted"
+			matchers := []alertmanager.Matcher{{Name: testMatcherName, Value: testMatcherValue, IsEqual: true}}
+			for _, s := range []alertmanager.Silence{
+				{ID: "management", Comment: other.String(), CreatedBy: other.Instance.CreatedBy()},
+				{ID: "legacy", Comment: identity.Legacy(), CreatedBy: alertmanager.CreatedBy},
+				{ID: "orphan", Comment: orphan.String(), CreatedBy: reconciler.instance.CreatedBy()},
+			} {
+				s.Matchers = matchers
+				s.StartsAt = time.Now().Add(-2 * time.Hour)
+				s.EndsAt = time.Now().Add(time.Hour)
+				s.Status = &alertmanager.Status{State: "active"}
+				mockServer.AddSilence(&s)
+			}
+
+			By("creating its own silence next to the ones of other instances")
+			doReconcile(silence.Name, silence.Namespace)
+			comment := reconciler.instance.SilenceComment(silence)
+			got := findSilenceByComment(listSilences(), comment)
+			Expect(got).NotTo(BeNil())
+			Expect(got.CreatedBy).To(Equal("silence-operator/workload"))
+			Expect(comment).To(ContainSubstring("instance=workload"))
+
+			By("only expiring its own orphaned silences")
+			sweeper := &orphanSweeper{reconciler: reconciler, gracePeriod: time.Hour}
+			Expect(sweeper.sweep(ctx)).To(Succeed())
+			ids := func() []string {
+				var ids []string
+				for _, s := range listSilences() {
+					ids = append(ids, s.ID)
+				}
+				return ids
+			}
+			Expect(ids()).To(ConsistOf(got.ID, "management", "legacy"))
+
+			By("only deleting its own silence with the Silence")
+			Expect(k8sClient.Delete(ctx, silence)).To(Succeed())
+			doReconcile(silence.Name, silence.Namespace)
+			Expect(ids()).To(ConsistOf("management", "legacy"))
+		})
+	})
+
+	Context("Adoption", func() {
+		It("should adopt selected manual silences and take over the Alertmanager silence", func() {
+			mockServer.AddSilence(&alertmanager.Silence{
+				ID: "manual-1", Comment: "Maintenance of node pool", CreatedBy: "alice",
+				Matchers: []alertmanager.Matcher{
+					{Name: "namespace", Value: "default", IsEqual: true},
+					{Name: "alertname", Value: "Node.*", IsRegex: true, IsEqual: true},
+				},
+				StartsAt: time.Now().Add(-time.Hour), EndsAt: time.Now().Add(time.Hour),
+				Status: &alertmanager.Status{State: "active"},
+			})
+			mockServer.AddSilence(&alertmanager.Silence{
+				ID: "manual-2", Comment: "Not for adoption", CreatedBy: "bob",
+				Matchers: []alertmanager.Matcher{{Name: "namespace", Value: "default", IsEqual: true}},
+				StartsAt: time.Now().Add(-time.Hour), EndsAt: time.Now().Add(time.Hour),
+				Status: &alertmanager.Status{State: "active"},
+			})
+
+			adopter := &silenceAdopter{
+				reconciler:     reconciler,
+				createdBy:      regexp.MustCompile("^alice$"),
+				namespaceLabel: "namespace",
+			}
+			Expect(adopter.adopt(ctx)).To(Succeed())
+
+			silence := &observabilityv1alpha2.Silence{}
+			key := client.ObjectKey{Namespace: "default", Name: "adopted-manual-1"}
+			Expect(k8sClient.Get(ctx, key, silence)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, silence)).To(Succeed()) })
+			Expect(silence.Annotations).To(HaveKeyWithValue(AdoptedFromAnnotation, "manual-1"))
+			Expect(silence.Spec.Owner).To(Equal("alice"))
+			Expect(silence.Spec.Description).To(Equal("Maintenance of node pool"))
+			Expect(silence.Spec.Matchers).To(ConsistOf(
+				observabilityv1alpha2.SilenceMatcher{Name: "namespace", Value: "default", MatchType: observabilityv1alpha2.MatchEqual},
+				observabilityv1alpha2.SilenceMatcher{Name: "alertname", Value: "Node.*", MatchType: observabilityv1alpha2.MatchRegexMatch},
+			))
+			Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: "adopted-manual-2"}, &observabilityv1alpha2.Silence{})).NotTo(Succeed())
+
+			By("taking over the Alertmanager silence instead of creating another one")
+			doReconcile(key.Name, key.Namespace)
+
+			silences := listSilences()
+			Expect(silences).To(HaveLen(2))
+			adopted := findSilenceByComment(silences, alertmanager.SilenceComment(silence))
+			Expect(adopted).NotTo(BeNil())
+			Expect(adopted.ID).To(Equal("manual-1"))
+			Expect(adopted.CreatedBy).To(Equal(alertmanager.CreatedBy))
+
+			Expect(k8sClient.Get(ctx, key, silence)).To(Succeed())
+			Expect(silence.Status.SilenceID).To(Equal("manual-1"))
+
+			By("not adopting the silence again")
+			Expect(adopter.adopt(ctx)).To(Succeed())
+		})
+
+		It("should not adopt a silence again once its Silence was deleted", func() {
+			mockServer.AddSilence(&alertmanager.Silence{
+				ID: "manual-declined", Comment: "Maintenance of node pool", CreatedBy: "alice",
+				Matchers: []alertmanager.Matcher{{Name: "alertname", Value: "NodeDown", IsEqual: true}},
+				StartsAt: time.Now().Add(-time.Hour),
+				EndsAt:   time.Now().Add(time.Hour),
+				Status:   &alertmanager.Status{State: "active"},
+			})
+
+			adopter := &silenceAdopter{
+				reconciler:       reconciler,
+				createdBy:        regexp.MustCompile("^alice$"),
+				defaultNamespace: "default",
+			}
+			Expect(adopter.adopt(ctx)).To(Succeed())
+
+			silence := &observabilityv1alpha2.Silence{}
+			key := client.ObjectKey{Namespace: "default", Name: "adopted-manual-declined"}
+			Expect(k8sClient.Get(ctx, key, silence)).To(Succeed())
+
+			By("declining the adoption by deleting the Silence before it took the silence over")
+			Expect(k8sClient.Delete(ctx, silence)).To(Succeed())
+			Expect(adopter.adopt(ctx)).To(Succeed())
+			Expect(errors.IsNotFound(k8sClient.Get(ctx, key, &observabilityv1alpha2.Silence{}))).To(BeTrue())
+		})
+	})
+
+	Context("Metrics", func() {
+		It("should report managed silences by namespace and state", func() {
+			silenceCount := func(namespace, state string) float64 {
+				registry := prometheus.NewPedanticRegistry()
+				registry.MustRegister(&silenceCollector{reconciler: reconciler})
+				families, err := registry.Gather()
+				Expect(err).NotTo(HaveOccurred())
+				for _, family := range families {
+					for _, metric := range family.GetMetric() {
+						labels := map[string]string{}
+						for _, label := range metric.GetLabel() {
+							labels[label.GetName()] = label.GetValue()
+						}
+						if labels["namespace"] == namespace && labels["state"] == state {
+							return metric.GetGauge().GetValue()
+						}
+					}
+				}
+				return 0
+			}
+
+			duration := observabilityv1alpha2.SilenceDuration("1h")
+			silence := &observabilityv1alpha2.Silence{
+				ObjectMeta: metav1.ObjectMeta{Name: "silence-metrics", Namespace: "default"},
+				Spec: observabilityv1alpha2.SilenceSpec{
+					Duration: &duration,
+					Matchers: []observabilityv1alpha2.SilenceMatcher{
+						{Name: testMatcherName, Value: testMatcherValue, MatchType: observabilityv1alpha2.MatchEqual},
+					},
+				},
+			}
+			active := silenceCount("default", observabilityv1alpha2.ReasonActive)
+			unknown := silenceCount("default", silenceStateUnknown)
+
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, silence)).To(Succeed()) })
+			Expect(silenceCount("default", silenceStateUnknown)).To(Equal(unknown + 1))
+
+			doReconcile(silence.Name, silence.Namespace)
+			Expect(silenceCount("default", silenceStateUnknown)).To(Equal(unknown))
+			Expect(silenceCount("default", observabilityv1alpha2.ReasonActive)).To(Equal(active + 1))
+		})
+	})
+
+	Context("Events", func() {
+		It("should record the lifecycle of the Alertmanager silence", func() {
+			recorder := events.NewFakeRecorder(10)
+			reconciler.recorder = recorder
+
+			endsAt := metav1.NewTime(time.Now().Add(time.Hour).Truncate(time.Second))
+			silence := &observabilityv1alpha2.Silence{
+				ObjectMeta: metav1.ObjectMeta{Name: "silence-events", Namespace: "default"},
+				Spec: observabilityv1alpha2.SilenceSpec{
+					EndsAt: &endsAt,
+					Matchers: []observabilityv1alpha2.SilenceMatcher{
+						{Name: testMatcherName, Value: "EventsBefore", MatchType: observabilityv1alpha2.MatchEqual},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+
+			By("recording the creation")
+			doReconcile(silence.Name, silence.Namespace)
+			Expect(recorder.Events).To(Receive(HavePrefix("Normal Created Created silence")))
+
+			By("recording the changes of an update")
+			updated := &observabilityv1alpha2.Silence{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(silence), updated)).To(Succeed())
+			newEndsAt := metav1.NewTime(endsAt.Add(time.Hour))
+			updated.Spec.EndsAt = &newEndsAt
+			updated.Spec.Matchers[0].Value = "EventsAfter"
+			Expect(k8sClient.Update(ctx, updated)).To(Succeed())
+			doReconcile(silence.Name, silence.Namespace)
+			var event string
+			Expect(recorder.Events).To(Receive(&event))
+			Expect(event).To(HavePrefix("Normal Updated"))
+			Expect(event).To(ContainSubstring(`matchers {alertname="EventsBefore"} -> {alertname="EventsAfter"}`))
+			Expect(event).To(ContainSubstring("endsAt %s -> %s", endsAt.UTC().Format(time.RFC3339), newEndsAt.UTC().Format(time.RFC3339)))
+
+			By("recording the deletion")
+			Expect(k8sClient.Delete(ctx, updated)).To(Succeed())
+			doReconcile(silence.Name, silence.Namespace)
+			Expect(recorder.Events).To(Receive(HavePrefix("Normal Deleted")))
+		})
+
+		It("should record silences expired by Alertmanager", func() {
+			recorder := events.NewFakeRecorder(10)
+			reconciler.recorder = recorder
+
+			startsAt := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+			endsAt := metav1.NewTime(time.Now().Add(2 * time.Second).Truncate(time.Second))
+			silence := &observabilityv1alpha2.Silence{
+				ObjectMeta: metav1.ObjectMeta{Name: "silence-events-expired", Namespace: "default"},
+				Spec: observabilityv1alpha2.SilenceSpec{
+					StartsAt: &startsAt,
+					EndsAt:   &endsAt,
+					Matchers: []observabilityv1alpha2.SilenceMatcher{
+						{Name: testMatcherName, Value: "EventsExpired", MatchType: observabilityv1alpha2.MatchEqual},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+			DeferCleanup(func() {
+				if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(silence), silence); err == nil {
+					silence.Finalizers = nil
+					Expect(k8sClient.Update(ctx, silence)).To(Succeed())
+					Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, silence))).To(Succeed())
+				}
+			})
+
+			doReconcile(silence.Name, silence.Namespace)
+			Expect(recorder.Events).To(Receive(HavePrefix("Normal Created")))
+
+			By("expiring the silence in Alertmanager, which hides it")
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(silence), silence)).To(Succeed())
+			mockServer.RemoveSilence(silence.Status.SilenceID)
+			time.Sleep(time.Until(endsAt.Time))
+
+			doReconcile(silence.Name, silence.Namespace)
+			Expect(recorder.Events).To(Receive(Equal(fmt.Sprintf("Normal Expired Silence expired at %s", endsAt.UTC().Format(time.RFC3339)))))
+
+			By("recording the expiry once")
+			doReconcile(silence.Name, silence.Namespace)
+			Expect(recorder.Events).NotTo(Receive(HavePrefix("Normal Expired")))
+		})
+
+		It("should record sync failures with the Alertmanager error", func() {
+			recorder := events.NewFakeRecorder(10)
+			reconciler.recorder = recorder
+			mockServer.Close()
+
+			duration := observabilityv1alpha2.SilenceDuration("1h")
+			silence := &observabilityv1alpha2.Silence{
+				ObjectMeta: metav1.ObjectMeta{Name: "silence-events-failure", Namespace: "default"},
+				Spec: observabilityv1alpha2.SilenceSpec{
+					Duration: &duration,
+					Matchers: []observabilityv1alpha2.SilenceMatcher{
+						{Name: testMatcherName, Value: testMatcherValue, MatchType: observabilityv1alpha2.MatchEqual},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(silence), silence)).To(Succeed())
+				silence.Finalizers = nil
+				Expect(k8sClient.Update(ctx, silence)).To(Succeed())
+				Expect(k8sClient.Delete(ctx, silence)).To(Succeed())
+			})
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(silence)})
+			Expect(err).To(HaveOccurred())
+			Expect(recorder.Events).To(Receive(SatisfyAll(
+				HavePrefix("Warning SyncFailed Failed to sync silence with Alertmanager"),
+				ContainSubstring("connection refused"),
+			)))
+		})
+
+		It("should report silences rejected by Alertmanager without retrying them", func() {
+			recorder := events.NewFakeRecorder(10)
+			reconciler.recorder = recorder
+			mockServer.RejectSilences("silence invalid: at least one matcher must not match the empty string")
+
+			duration := observabilityv1alpha2.SilenceDuration("1h")
+			silence := &observabilityv1alpha2.Silence{
+				ObjectMeta: metav1.ObjectMeta{Name: "silence-events-rejected", Namespace: "default"},
+				Spec: observabilityv1alpha2.SilenceSpec{
+					Duration: &duration,
+					Matchers: []observabilityv1alpha2.SilenceMatcher{
+						{Name: testMatcherName, Value: testMatcherValue, MatchType: observabilityv1alpha2.MatchEqual},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(silence), silence)).To(Succeed())
+				silence.Finalizers = nil
+				Expect(k8sClient.Update(ctx, silence)).To(Succeed())
+				Expect(k8sClient.Delete(ctx, silence)).To(Succeed())
+			})
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(silence)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorder.Events).To(Receive(SatisfyAll(
+				HavePrefix("Warning Rejected Failed to sync silence with Alertmanager"),
+				ContainSubstring("got 400: silence invalid"),
+			)))
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(silence), silence)).To(Succeed())
+			ready := meta.FindStatusCondition(silence.Status.Conditions, observabilityv1alpha2.ConditionReady)
+			Expect(ready).NotTo(BeNil())
+			Expect(ready.Reason).To(Equal(observabilityv1alpha2.ReasonRejected))
+		})
+	})
+
+	Context("Metadata", func() {
+		It("should render description, owner and links into the Alertmanager comment", func() {
+			duration := observabilityv1alpha2.SilenceDuration("1h")
+			silence := &observabilityv1alpha2.Silence{
+				ObjectMeta: metav1.ObjectMeta{Name: "silence-metadata", Namespace: "default"},
+				Spec: observabilityv1alpha2.SilenceSpec{
+					Duration:    &duration,
+					Description: "Cluster is being decommissioned.",
+					Owner:       "team-atlas",
+					Links: []observabilityv1alpha2.SilenceLink{
+						{Name: "Issue", URL: "https://github.com/giantswarm/giantswarm/issues/1"},
+					},
+					Matchers: []observabilityv1alpha2.SilenceMatcher{
+						{Name: testMatcherName, Value: testMatcherValue, MatchType: observabilityv1alpha2.MatchEqual},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, silence)).To(Succeed()) })
+
+			doReconcile(silence.Name, silence.Namespace)
+
+			comment := alertmanager.SilenceComment(silence)
+			got := findSilenceByComment(listSilences(), comment)
+			Expect(got).NotTo(BeNil(), "silence %q not found in Alertmanager", comment)
+			Expect(got.Comment).To(Equal(comment + "\n\nCluster is being decommissioned.\n\n" +
+				"Owner: team-atlas\nIssue: https://github.com/giantswarm/giantswarm/issues/1"))
+
+			By("updating the description of the existing Alertmanager silence")
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(silence), silence)).To(Succeed())
+			silence.Spec.Description = "Cluster was decommissioned."
+			silence.Spec.Links = nil
+			Expect(k8sClient.Update(ctx, silence)).To(Succeed())
+
+			doReconcile(silence.Name, silence.Namespace)
+
+			silences := listSilences()
+			Expect(silences).To(HaveLen(1))
+			Expect(silences[0].ID).To(Equal(got.ID))
+			Expect(silences[0].Comment).To(Equal(comment + "\n\nCluster was decommissioned.\n\nOwner: team-atlas"))
+		})
+	})
+
+	Context("Matched alerts preview", func() {
+		It("should report the firing alerts matched by the silence", func() {
+			mockServer.AddAlert(map[string]string{"alertname": "PreviewAlert", "cluster": "a"})
+			mockServer.AddAlert(map[string]string{"alertname": "PreviewAlert", "cluster": "b"})
+			mockServer.AddAlert(map[string]string{"alertname": "OtherAlert", "cluster": "a"})
+
+			duration := observabilityv1alpha2.SilenceDuration("1h")
+			silence := &observabilityv1alpha2.Silence{
+				ObjectMeta: metav1.ObjectMeta{Name: "silence-preview", Namespace: "default"},
+				Spec: observabilityv1alpha2.SilenceSpec{
+					Duration: &duration,
+					Matchers: []observabilityv1alpha2.SilenceMatcher{
+						{Name: "alertname", Value: "PreviewAlert", MatchType: observabilityv1alpha2.MatchEqual},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, silence)).To(Succeed()) })
+
+			doReconcile(silence.Name, silence.Namespace)
+
+			reconciled := &observabilityv1alpha2.Silence{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(silence), reconciled)).To(Succeed())
+			Expect(reconciled.Status.MatchedAlerts).NotTo(BeNil())
+			Expect(reconciled.Status.MatchedAlerts.Count).To(Equal(int32(2)))
+			Expect(reconciled.Status.MatchedAlerts.AlertNames).To(Equal([]string{"PreviewAlert"}))
+			Expect(reconciled.Status.MatchedAlerts.ObservedGeneration).To(Equal(reconciled.Generation))
+			Expect(reconciled.Status.MatchedAlerts.LastUpdateTime).NotTo(BeNil())
+
+			By("reusing the preview when the unchanged silence is reconciled again")
+			requests := mockServer.ListAlertsRequests()
+			doReconcile(silence.Name, silence.Namespace)
+			Expect(mockServer.ListAlertsRequests()).To(Equal(requests))
+
+			By("refreshing the preview when the silence changes")
+			mockServer.AddAlert(map[string]string{"alertname": "OtherAlert", "cluster": "b"})
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(silence), reconciled)).To(Succeed())
+			reconciled.Spec.Matchers[0].Value = "OtherAlert"
+			Expect(k8sClient.Update(ctx, reconciled)).To(Succeed())
+			doReconcile(silence.Name, silence.Namespace)
+			Expect(mockServer.ListAlertsRequests()).To(Equal(requests + 1))
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(silence), reconciled)).To(Succeed())
+			Expect(reconciled.Status.MatchedAlerts.Count).To(Equal(int32(2)))
+			Expect(reconciled.Status.MatchedAlerts.ObservedGeneration).To(Equal(reconciled.Generation))
+
+			By("refreshing the preview once it is older than the refresh interval")
+			reconciler.matchedAlertsRefreshInterval = time.Nanosecond
+			doReconcile(silence.Name, silence.Namespace)
+			Expect(mockServer.ListAlertsRequests()).To(Equal(requests + 2))
+		})
+	})
+
+	Context("AlertmanagerTarget routing", func() {
+		var targetServer *testutils.MockAlertmanagerServer
+
+		BeforeEach(func() {
+			targetServer = testutils.NewMockAlertmanagerServer()
+		})
+
+		AfterEach(func() {
+			targetServer.Close()
+		})
+
+		createTarget := func(name string, targetLabels map[string]string) *observabilityv1alpha2.AlertmanagerTarget {
+			target := &observabilityv1alpha2.AlertmanagerTarget{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Labels: targetLabels},
+				Spec: observabilityv1alpha2.AlertmanagerTargetSpec{
+					Address: targetServer.URL(),
+					Tenant:  "team-a",
+				},
+			}
+			Expect(k8sClient.Create(ctx, target)).To(Succeed())
+			DeferCleanup(func() { Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, target))).To(Succeed()) })
+			return target
+		}
+
+		newRoutedSilence := func(name string) *observabilityv1alpha2.Silence {
+			duration := observabilityv1alpha2.SilenceDuration("1h")
+			return &observabilityv1alpha2.Silence{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				Spec: observabilityv1alpha2.SilenceSpec{
+					Duration: &duration,
+					Matchers: []observabilityv1alpha2.SilenceMatcher{
+						{Name: "alertname", Value: "RoutedAlert", MatchType: observabilityv1alpha2.MatchEqual},
+					},
+				},
+			}
+		}
+
+		It("should sync to the referenced target instead of the default Alertmanager", func() {
+			createTarget("target-ref", nil)
+
+			silence := newRoutedSilence("silence-target-ref")
+			silence.Spec.TargetRef = &observabilityv1alpha2.AlertmanagerTargetReference{Name: "target-ref"}
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, silence)).To(Succeed()) })
+
+			doReconcile(silence.Name, silence.Namespace)
+
+			comment := alertmanager.SilenceComment(silence)
+			Expect(targetServer.GetSilences()).To(ContainElement(HaveField("Comment", comment)))
+			Expect(findSilenceByComment(listSilences(), comment)).To(BeNil())
+
+			reconciled := &observabilityv1alpha2.Silence{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(silence), reconciled)).To(Succeed())
+			Expect(reconciled.Status.Targets).To(ConsistOf(HaveField("Name", "target-ref")))
+			Expect(reconciled.Status.Targets[0].Tenant).To(Equal("team-a"))
+			Expect(meta.IsStatusConditionTrue(reconciled.Status.Conditions, observabilityv1alpha2.ConditionSynced)).To(BeTrue())
+		})
+
+		It("should sync to every target matching the selector", func() {
+			createTarget("target-prod-a", map[string]string{"env": "prod"})
+			createTarget("target-prod-b", map[string]string{"env": "prod"})
+			createTarget("target-dev", map[string]string{"env": "dev"})
+
+			silence := newRoutedSilence("silence-target-selector")
+			silence.Spec.TargetSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, silence)).To(Succeed()) })
+
+			doReconcile(silence.Name, silence.Namespace)
+
+			reconciled := &observabilityv1alpha2.Silence{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(silence), reconciled)).To(Succeed())
+			Expect(reconciled.Status.Targets).To(ConsistOf(
+				HaveField("Name", "target-prod-a"),
+				HaveField("Name", "target-prod-b"),
+			))
+		})
+
+		It("should report a missing target without failing the reconciliation", func() {
+			silence := newRoutedSilence("silence-target-missing")
+			silence.Spec.TargetRef = &observabilityv1alpha2.AlertmanagerTargetReference{Name: "does-not-exist"}
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, silence)).To(Succeed()) })
+
+			doReconcile(silence.Name, silence.Namespace)
+
+			reconciled := &observabilityv1alpha2.Silence{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(silence), reconciled)).To(Succeed())
+			condition := meta.FindStatusCondition(reconciled.Status.Conditions, observabilityv1alpha2.ConditionSynced)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(observabilityv1alpha2.ReasonTargetNotFound))
+		})
+
+		It("should remove the silence from a target once it is routed back to the default Alertmanager", func() {
+			createTarget("target-switch", nil)
+
+			silence := newRoutedSilence("silence-target-switch")
+			silence.Spec.TargetRef = &observabilityv1alpha2.AlertmanagerTargetReference{Name: "target-switch"}
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, silence)).To(Succeed()) })
+
+			doReconcile(silence.Name, silence.Namespace)
+			Expect(targetServer.GetSilences()).To(HaveLen(1))
+
+			updated := &observabilityv1alpha2.Silence{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(silence), updated)).To(Succeed())
+			updated.Spec.TargetRef = nil
+			Expect(k8sClient.Update(ctx, updated)).To(Succeed())
+
+			doReconcile(silence.Name, silence.Namespace)
+
+			comment := alertmanager.SilenceComment(silence)
+			Expect(targetServer.GetSilences()).To(BeEmpty())
+			Expect(findSilenceByComment(listSilences(), comment)).NotTo(BeNil())
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(silence), updated)).To(Succeed())
+			Expect(updated.Status.Targets).To(BeEmpty())
+		})
+	})
+
+	Context("Approval", func() {
+		BeforeEach(func() {
+			reconciler.approvalPolicy = approval.NewPolicy(config.Config{
+				ApprovalEnabled:     true,
+				ApprovalMaxDuration: 24 * time.Hour,
+			})
+		})
+
+		It("should hold back risky silences until they are approved by someone else", func() {
+			duration := observabilityv1alpha2.SilenceDuration("2w")
+			silence := &observabilityv1alpha2.Silence{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "silence-approval",
+					Namespace:   "default",
+					Annotations: map[string]string{approval.CreatedByAnnotation: "alice"},
+				},
+				Spec: observabilityv1alpha2.SilenceSpec{
+					Duration: &duration,
+					Matchers: []observabilityv1alpha2.SilenceMatcher{
+						{Name: "alertname", Value: "ApprovalTest", MatchType: observabilityv1alpha2.MatchEqual},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, silence)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, silence)).To(Succeed()) })
+
+			doReconcile(silence.Name, silence.Namespace)
+
+			comment := alertmanager.SilenceComment(silence)
+			Expect(findSilenceByComment(listSilences(), comment)).To(BeNil())
+
+			pending := &observabilityv1alpha2.Silence{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(silence), pending)).To(Succeed())
+			condition := meta.FindStatusCondition(pending.Status.Conditions, observabilityv1alpha2.ConditionSynced)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(observabilityv1alpha2.ReasonPendingApproval))
+			Expect(condition.Message).To(ContainSubstring("longer than 24h0m0s"))
+
+			By("ignoring an approval by the creator")
+			pending.Annotations[approval.ApprovedByAnnotation] = "alice"
+			Expect(k8sClient.Update(ctx, pending)).To(Succeed())
+			doReconcile(silence.Name, silence.Namespace)
+			Expect(findSilenceByComment(listSilences(), comment)).To(BeNil())
+
+			By("syncing once someone else approved it")
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(silence), pending)).To(Succeed())
+			pending.Annotations[approval.ApprovedByAnnotation] = "bob"
+			Expect(k8sClient.Update(ctx, pending)).To(Succeed())
+			doReconcile(silence.Name, silence.Namespace)
+			Expect(findSilenceByComment(listSilences(), comment)).NotTo(BeNil())
+		})
+	})
+
 	Context("Finalizer Handling with CRDs", func() {
 		It("should add finalizer on create and remove it on delete", func() {
 			duration := observabilityv1alpha2.SilenceDuration("1h")
@@ -0,0 +1,232 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/giantswarm/silence-operator/api/v1alpha2"
+	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+	"github.com/giantswarm/silence-operator/pkg/metrics"
+	"github.com/giantswarm/silence-operator/pkg/service"
+)
+
+// driftSource periodically enqueues the silences whose Alertmanager silence is missing or
+// differs from them, e.g. because it was expired in the Alertmanager UI or Alertmanager
+// lost its state, so they are synced again without waiting for the Silence to change.
+type driftSource struct {
+	reconciler *SilenceV2Reconciler
+	interval   time.Duration
+}
+
+// Start polls Alertmanager every interval until ctx is done.
+func (s *driftSource) Start(ctx context.Context, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) error {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, request := range s.reconciler.detectDrift(ctx) {
+					queue.Add(request)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// driftGroup is an Alertmanager tenant and the silences expected in it.
+type driftGroup struct {
+	target   service.Target
+	expected map[string]driftExpectation
+}
+
+// targetKey identifies an Alertmanager tenant of a target.
+type targetKey struct {
+	name   string
+	tenant string
+}
+
+// driftExpectation is the Alertmanager silence expected for a Silence.
+type driftExpectation struct {
+	key     types.NamespacedName
+	silence *alertmanager.Silence
+}
+
+// detectDrift lists the silences of every Alertmanager tenant the selected silences are
+// synced to and returns the silences whose Alertmanager silence is missing or differs.
+func (r *SilenceV2Reconciler) detectDrift(ctx context.Context) []reconcile.Request {
+	logger := log.FromContext(ctx).WithName("drift-detection")
+
+	groups, err := r.driftGroups(ctx)
+	if err != nil {
+		logger.Error(err, "Failed to compute the expected Alertmanager silences")
+		return nil
+	}
+
+	now := time.Now()
+	enqueued := map[types.NamespacedName]bool{}
+	var requests []reconcile.Request
+	for _, group := range groups {
+		silences, err := r.silenceService.ListSilences(ctx, group.target)
+		if err != nil {
+			metrics.DriftPolls.WithLabelValues("error").Inc()
+			logger.Error(err, "Failed to list silences for drift detection", "target", group.target.Name, "tenant", group.target.Tenant)
+			continue
+		}
+		metrics.DriftPolls.WithLabelValues("success").Inc()
+
+		current := map[string]*alertmanager.Silence{}
+		for i := range silences {
+			if r.instance.Owns(&silences[i]) {
+				current[alertmanager.CommentIdentity(silences[i].Comment)] = &silences[i]
+			}
+		}
+
+		for identity, expected := range group.expected {
+			reason := metrics.DriftReasonChanged
+			var changes []string
+			existing, ok := current[identity]
+			if ok {
+				changes = service.Diff(existing, expected.silence, now)
+			}
+			switch {
+			case !ok:
+				reason = metrics.DriftReasonMissing
+			case len(changes) == 0:
+				continue
+			}
+
+			metrics.DriftDetected.WithLabelValues(reason).Inc()
+			logger.Info("Detected drift of Alertmanager silence", "namespace", expected.key.Namespace, "name", expected.key.Name,
+				"target", group.target.Name, "tenant", group.target.Tenant, "reason", reason, "changes", changes)
+			if !enqueued[expected.key] {
+				enqueued[expected.key] = true
+				requests = append(requests, reconcile.Request{NamespacedName: expected.key})
+			}
+		}
+	}
+	return requests
+}
+
+// driftGroups returns the Alertmanager silences expected for the selected silences,
+// grouped by the target and tenant they are synced to. Only silences whose last sync of
+// their current generation succeeded and that have not ended are expected.
+func (r *SilenceV2Reconciler) driftGroups(ctx context.Context) (map[targetKey]*driftGroup, error) {
+	logger := log.FromContext(ctx)
+
+	silences := &v1alpha2.SilenceList{}
+	if err := r.client.List(ctx, silences); err != nil {
+		return nil, err
+	}
+
+	// AlertmanagerTargets are resolved once per poll, as many silences share them.
+	amTargets := map[string]*v1alpha2.AlertmanagerTarget{}
+	targets := map[targetKey]service.Target{}
+	if anyUsesTargets(silences.Items) {
+		list := &v1alpha2.AlertmanagerTargetList{}
+		if err := r.client.List(ctx, list); err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			amTargets[list.Items[i].Name] = &list.Items[i]
+		}
+	}
+
+	now := time.Now()
+	groups := map[targetKey]*driftGroup{}
+	add := func(target service.Target, silence *v1alpha2.Silence, expected *alertmanager.Silence) {
+		key := targetKey{name: target.Name, tenant: target.Tenant}
+		group, ok := groups[key]
+		if !ok {
+			group = &driftGroup{target: target, expected: map[string]driftExpectation{}}
+			groups[key] = group
+		}
+		group.expected[alertmanager.CommentIdentity(expected.Comment)] = driftExpectation{
+			key:     client.ObjectKeyFromObject(silence),
+			silence: expected,
+		}
+	}
+
+	for i := range silences.Items {
+		silence := &silences.Items[i]
+		if !silence.DeletionTimestamp.IsZero() || !r.selected(silence) || !isSyncedGeneration(silence) {
+			continue
+		}
+
+		expected, err := r.getSilenceFromCR(silence)
+		if err != nil || !expected.EndsAt.After(now) {
+			continue
+		}
+
+		if !usesTargets(silence) {
+			add(r.silenceService.DefaultTarget(r.tenancyHelper.ExtractTenant(silence)), silence, expected)
+			continue
+		}
+
+		for _, status := range silence.Status.Targets {
+			if status.LastSyncError != "" {
+				continue
+			}
+			key := targetKey{name: status.Name, tenant: status.Tenant}
+			target, ok := targets[key]
+			if !ok {
+				amTarget, found := amTargets[status.Name]
+				if !found {
+					continue
+				}
+				target, err = r.target(ctx, amTarget, status.Tenant)
+				if err != nil {
+					logger.Error(err, "Failed to resolve AlertmanagerTarget for drift detection", "target", status.Name)
+					continue
+				}
+				targets[key] = target
+			}
+			add(target, silence, expected)
+		}
+	}
+	return groups, nil
+}
+
+// isSyncedGeneration returns true when the last sync of the current generation of the
+// silence succeeded.
+func isSyncedGeneration(silence *v1alpha2.Silence) bool {
+	return silence.Status.ObservedGeneration == silence.Generation &&
+		meta.IsStatusConditionTrue(silence.Status.Conditions, v1alpha2.ConditionSynced)
+}
+
+// anyUsesTargets reports whether any of the silences is routed to AlertmanagerTargets.
+func anyUsesTargets(silences []v1alpha2.Silence) bool {
+	for i := range silences {
+		if usesTargets(&silences[i]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/giantswarm/silence-operator/api/v1alpha2"
+	"github.com/giantswarm/silence-operator/pkg/metrics"
+)
+
+// silenceStateUnknown is reported for silences without a Ready condition, i.e. silences
+// not reconciled yet.
+const silenceStateUnknown = "Unknown"
+
+// collectTimeout bounds listing the silences when the metrics are scraped.
+const collectTimeout = 10 * time.Second
+
+// silenceCollector reports the number of silences selected by the reconciler by namespace
+// and the reason of their Ready condition. The silences are listed from the cache on every
+// scrape, so deleted silences and namespaces do not leave stale series behind.
+type silenceCollector struct {
+	reconciler *SilenceV2Reconciler
+}
+
+// Describe implements prometheus.Collector.
+func (c *silenceCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- metrics.Silences
+}
+
+// Collect implements prometheus.Collector. Nothing is reported when the silences cannot be
+// listed, e.g. before the cache has synced.
+func (c *silenceCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	silences := &v1alpha2.SilenceList{}
+	if err := c.reconciler.client.List(ctx, silences); err != nil {
+		ctrl.Log.WithName("silence-v2-controller").Error(err, "Failed to list silences for metrics")
+		return
+	}
+
+	type key struct{ namespace, state string }
+	counts := map[key]int{}
+	for i := range silences.Items {
+		silence := &silences.Items[i]
+		if !c.reconciler.selected(silence) {
+			continue
+		}
+		state := silenceStateUnknown
+		if ready := meta.FindStatusCondition(silence.Status.Conditions, v1alpha2.ConditionReady); ready != nil {
+			state = ready.Reason
+		}
+		counts[key{namespace: silence.Namespace, state: state}]++
+	}
+
+	for k, count := range counts {
+		ch <- prometheus.MustNewConstMetric(metrics.Silences, prometheus.GaugeValue, float64(count), k.namespace, k.state)
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/silence-operator/api/v1alpha2"
+	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+)
+
+// setSyncedStatus records a successful sync and derives the lifecycle conditions
+// from the effective start and end times already stored in the status.
+func setSyncedStatus(silence *v1alpha2.Silence, silenceID string, now time.Time) {
+	silence.Status.SilenceID = silenceID
+	silence.Status.LastSyncError = ""
+	setCondition(silence, v1alpha2.ConditionSynced, metav1.ConditionTrue, v1alpha2.ReasonSynced, "Silence is in sync with Alertmanager")
+
+	startsAt := silence.Status.StartsAt.Time
+	endsAt := silence.Status.EndsAt.Time
+
+	switch {
+	case now.Before(startsAt):
+		message := fmt.Sprintf("Silence starts at %s", startsAt.UTC().Format(time.RFC3339))
+		setCondition(silence, v1alpha2.ConditionScheduled, metav1.ConditionTrue, v1alpha2.ReasonPending, message)
+		setCondition(silence, v1alpha2.ConditionExpired, metav1.ConditionFalse, v1alpha2.ReasonPending, message)
+		setCondition(silence, v1alpha2.ConditionReady, metav1.ConditionFalse, v1alpha2.ReasonPending, message)
+	case !now.Before(endsAt):
+		message := fmt.Sprintf("Silence ended at %s", endsAt.UTC().Format(time.RFC3339))
+		setCondition(silence, v1alpha2.ConditionScheduled, metav1.ConditionFalse, v1alpha2.ReasonExpired, message)
+		setCondition(silence, v1alpha2.ConditionExpired, metav1.ConditionTrue, v1alpha2.ReasonExpired, message)
+		setCondition(silence, v1alpha2.ConditionReady, metav1.ConditionFalse, v1alpha2.ReasonExpired, message)
+	default:
+		message := fmt.Sprintf("Silence is active until %s", endsAt.UTC().Format(time.RFC3339))
+		setCondition(silence, v1alpha2.ConditionScheduled, metav1.ConditionFalse, v1alpha2.ReasonActive, message)
+		setCondition(silence, v1alpha2.ConditionExpired, metav1.ConditionFalse, v1alpha2.ReasonActive, message)
+		setCondition(silence, v1alpha2.ConditionReady, metav1.ConditionTrue, v1alpha2.ReasonActive, message)
+	}
+}
+
+// setSyncFailedStatus records a failed sync. The Scheduled and Expired conditions are
+// left untouched as they describe the last state known to be in Alertmanager.
+func setSyncFailedStatus(silence *v1alpha2.Silence, reason string, err error) {
+	silence.Status.LastSyncError = err.Error()
+	setCondition(silence, v1alpha2.ConditionSynced, metav1.ConditionFalse, reason, err.Error())
+	setCondition(silence, v1alpha2.ConditionReady, metav1.ConditionFalse, reason, err.Error())
+}
+
+// setPendingApprovalStatus records that the silence is not synced until it is approved.
+// Silences synced before keep their last approved state in Alertmanager.
+func setPendingApprovalStatus(silence *v1alpha2.Silence, risks []string) {
+	message := "Silence requires approval by someone other than its creator: " + strings.Join(risks, "; ")
+	setCondition(silence, v1alpha2.ConditionSynced, metav1.ConditionFalse, v1alpha2.ReasonPendingApproval, message)
+	setCondition(silence, v1alpha2.ConditionReady, metav1.ConditionFalse, v1alpha2.ReasonPendingApproval, message)
+}
+
+// maxMatchedAlertNames limits the alert names sampled into status.matchedAlerts.
+const maxMatchedAlertNames = 10
+
+// setMatchedAlertsStatus summarizes alerts in the status as previewed at now for the
+// current generation. Alerts reported by several Alertmanagers are counted once.
+func setMatchedAlertsStatus(silence *v1alpha2.Silence, alerts []alertmanager.Alert, now time.Time) {
+	fingerprints := map[string]bool{}
+	names := map[string]bool{}
+	for _, alert := range alerts {
+		fingerprints[alert.Fingerprint] = true
+		if name := alert.Labels["alertname"]; name != "" {
+			names[name] = true
+		}
+	}
+
+	alertNames := make([]string, 0, len(names))
+	for name := range names {
+		alertNames = append(alertNames, name)
+	}
+	slices.Sort(alertNames)
+	if len(alertNames) > maxMatchedAlertNames {
+		alertNames = alertNames[:maxMatchedAlertNames]
+	}
+
+	silence.Status.MatchedAlerts = &v1alpha2.MatchedAlertsStatus{
+		Count:              int32(len(fingerprints)),
+		AlertNames:         alertNames,
+		ObservedGeneration: silence.Generation,
+		LastUpdateTime:     &metav1.Time{Time: now},
+	}
+}
+
+func setCondition(silence *v1alpha2.Silence, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&silence.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: silence.Generation,
+	})
+}
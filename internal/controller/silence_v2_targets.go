@@ -0,0 +1,331 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/giantswarm/silence-operator/api/v1alpha2"
+	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+	"github.com/giantswarm/silence-operator/pkg/config"
+	"github.com/giantswarm/silence-operator/pkg/service"
+)
+
+// errTargetNotFound is returned when spec.targetRef or spec.targetSelector match no AlertmanagerTarget.
+var errTargetNotFound = errors.New("AlertmanagerTarget not found")
+
+// usesTargets reports whether the silence is routed to AlertmanagerTargets instead of
+// the default Alertmanager.
+func usesTargets(silence *v1alpha2.Silence) bool {
+	return silence.Spec.TargetRef != nil || silence.Spec.TargetSelector != nil
+}
+
+// resolveTargets returns the AlertmanagerTargets selected by spec.targetRef or spec.targetSelector.
+func (r *SilenceV2Reconciler) resolveTargets(ctx context.Context, silence *v1alpha2.Silence) ([]v1alpha2.AlertmanagerTarget, error) {
+	if ref := silence.Spec.TargetRef; ref != nil {
+		amTarget := v1alpha2.AlertmanagerTarget{}
+		err := r.client.Get(ctx, client.ObjectKey{Name: ref.Name}, &amTarget)
+		if apierrors.IsNotFound(err) {
+			return nil, errors.Wrapf(errTargetNotFound, "targetRef %q", ref.Name)
+		} else if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return []v1alpha2.AlertmanagerTarget{amTarget}, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(silence.Spec.TargetSelector)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid targetSelector")
+	}
+	list := &v1alpha2.AlertmanagerTargetList{}
+	if err := r.client.List(ctx, list, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(list.Items) == 0 {
+		return nil, errors.Wrapf(errTargetNotFound, "targetSelector %q", selector.String())
+	}
+	return list.Items, nil
+}
+
+// target returns the service.Target for amTarget. The client revision is made of the UID and
+// generation of amTarget, so that a target deleted and created again with the same name gets
+// a new client. The bearer token is read from the referenced Secret in the namespace of the
+// operator, whose resource version is part of the revision so that rotated tokens are
+// picked up.
+func (r *SilenceV2Reconciler) target(ctx context.Context, amTarget *v1alpha2.AlertmanagerTarget, tenant string) (service.Target, error) {
+	cfg := config.Config{
+		Address:        amTarget.Spec.Address,
+		RequestTimeout: r.requestTimeout,
+		MaxRetries:     r.maxRetries,
+	}
+	revision := string(amTarget.UID) + "/" + strconv.FormatInt(amTarget.Generation, 10)
+
+	if auth := amTarget.Spec.Authentication; auth != nil {
+		switch {
+		case auth.ServiceAccountToken:
+			cfg.Authentication = true
+			cfg.BearerToken = r.bearerToken
+		case auth.BearerTokenSecretRef != nil:
+			ref := auth.BearerTokenSecretRef
+			if r.secretNamespace == "" {
+				return service.Target{}, errors.New("no namespace configured for bearer token Secrets")
+			}
+			secret := &corev1.Secret{}
+			err := r.secretReader().Get(ctx, client.ObjectKey{Namespace: r.secretNamespace, Name: ref.Name}, secret)
+			if err != nil {
+				return service.Target{}, errors.Wrapf(err, "failed to get bearer token Secret %s/%s", r.secretNamespace, ref.Name)
+			}
+			token, ok := secret.Data[ref.Key]
+			if !ok {
+				return service.Target{}, errors.Errorf("bearer token Secret %s/%s has no key %q", r.secretNamespace, ref.Name, ref.Key)
+			}
+			cfg.Authentication = true
+			cfg.BearerToken = string(token)
+			revision += "/" + secret.ResourceVersion
+		}
+	}
+
+	return r.silenceService.Target(amTarget.Name, revision, tenant, cfg)
+}
+
+// secretReader returns the reader used for bearer token Secrets. Secrets are read
+// directly from the API server so that the operator does not cache every Secret.
+func (r *SilenceV2Reconciler) secretReader() client.Reader {
+	if r.apiReader != nil {
+		return r.apiReader
+	}
+	return r.client
+}
+
+// syncTargets syncs the silence to each of amTargets and records the outcome in
+// silence.Status.Targets. Every target is attempted even if others fail. The silence is
+// also removed from the targets it was synced to before but that are no longer selected.
+// It returns the targets the silence was synced to.
+func (r *SilenceV2Reconciler) syncTargets(ctx context.Context, silence *v1alpha2.Silence, alertmanagerSilence *alertmanager.Silence, amTargets []v1alpha2.AlertmanagerTarget, tenant string) ([]service.Target, error) {
+	logger := log.FromContext(ctx)
+
+	var errs []error
+	var synced []service.Target
+	statuses := make([]v1alpha2.SilenceTargetStatus, 0, len(amTargets))
+	selected := map[string]bool{}
+	ids := targetSilenceIDs(silence)
+
+	for i := range amTargets {
+		amTarget := &amTargets[i]
+		selected[amTarget.Name] = true
+
+		status := v1alpha2.SilenceTargetStatus{Name: amTarget.Name, Tenant: tenant}
+		if amTarget.Spec.Tenant != "" {
+			status.Tenant = amTarget.Spec.Tenant
+		}
+
+		target, err := r.target(ctx, amTarget, status.Tenant)
+		if err == nil {
+			targetSilence := *alertmanagerSilence
+			targetSilence.ID = ids[amTarget.Name]
+			var result service.SyncResult
+			result, err = r.silenceService.SyncSilenceToTarget(ctx, &targetSilence, target)
+			status.SilenceID = result.SilenceID
+			if err == nil {
+				recordSyncEvent(r.recorder, silence, result, &targetSilence, amTarget.Name)
+				logSyncChanges(ctx, result, amTarget.Name)
+			}
+		}
+		if err != nil {
+			logger.Error(err, "Failed to sync silence with AlertmanagerTarget", "target", amTarget.Name, "tenant", status.Tenant)
+			err = errors.Wrapf(err, "AlertmanagerTarget %s", amTarget.Name)
+			status.LastSyncError = err.Error()
+			errs = append(errs, err)
+		} else {
+			synced = append(synced, target)
+		}
+		statuses = append(statuses, status)
+	}
+
+	remaining, err := r.removeFromTargets(ctx, alertmanagerSilence.Comment, silence.Status.Targets, selected)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	statuses = append(statuses, remaining...)
+
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	silence.Status.Targets = statuses
+
+	return synced, utilerrors.NewAggregate(errs)
+}
+
+// removeFromTargets deletes the silence from every target in previous that is not in
+// keep. It returns the targets the silence could not be deleted from, so that the
+// deletion is retried on the next reconciliation.
+func (r *SilenceV2Reconciler) removeFromTargets(ctx context.Context, comment string, previous []v1alpha2.SilenceTargetStatus, keep map[string]bool) ([]v1alpha2.SilenceTargetStatus, error) {
+	logger := log.FromContext(ctx)
+
+	var errs []error
+	var remaining []v1alpha2.SilenceTargetStatus
+	for _, status := range previous {
+		if keep[status.Name] {
+			continue
+		}
+
+		logger.Info("Deleting silence from AlertmanagerTarget it is no longer synced to", "target", status.Name, "tenant", status.Tenant)
+		if err := r.deleteFromTarget(ctx, comment, status); err != nil {
+			err = errors.Wrapf(err, "AlertmanagerTarget %s", status.Name)
+			status.LastSyncError = err.Error()
+			remaining = append(remaining, status)
+			errs = append(errs, err)
+		}
+	}
+	return remaining, utilerrors.NewAggregate(errs)
+}
+
+// deleteFromTarget deletes the silence from the AlertmanagerTarget it was synced to as
+// recorded in status. A target that no longer exists is skipped as the operator cannot
+// reach its Alertmanager anymore.
+func (r *SilenceV2Reconciler) deleteFromTarget(ctx context.Context, comment string, status v1alpha2.SilenceTargetStatus) error {
+	amTarget := &v1alpha2.AlertmanagerTarget{}
+	if err := r.client.Get(ctx, client.ObjectKey{Name: status.Name}, amTarget); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.FromContext(ctx).Info("AlertmanagerTarget no longer exists, skipping silence deletion", "target", status.Name)
+			return nil
+		}
+		return errors.WithStack(err)
+	}
+
+	target, err := r.target(ctx, amTarget, status.Tenant)
+	if err != nil {
+		return err
+	}
+	return r.silenceService.DeleteSilenceFromTarget(ctx, comment, status.SilenceID, target)
+}
+
+// targetSilenceIDs returns the silence IDs recorded in the status by target name.
+func targetSilenceIDs(silence *v1alpha2.Silence) map[string]string {
+	ids := map[string]string{}
+	for _, status := range silence.Status.Targets {
+		ids[status.Name] = status.SilenceID
+	}
+	return ids
+}
+
+// reconcileDeleteTargets deletes the silence from the targets it is currently selecting
+// and from the targets recorded in its status.
+func (r *SilenceV2Reconciler) reconcileDeleteTargets(ctx context.Context, silence *v1alpha2.Silence, comment, tenant string) error {
+	amTargets, err := r.resolveTargets(ctx, silence)
+	if err != nil && !errors.Is(err, errTargetNotFound) {
+		return err
+	}
+
+	ids := targetSilenceIDs(silence)
+	deleted := map[string]bool{}
+	for i := range amTargets {
+		amTarget := &amTargets[i]
+		targetTenant := tenant
+		if amTarget.Spec.Tenant != "" {
+			targetTenant = amTarget.Spec.Tenant
+		}
+		target, err := r.target(ctx, amTarget, targetTenant)
+		if err != nil {
+			return err
+		}
+		if err := r.silenceService.DeleteSilenceFromTarget(ctx, comment, ids[amTarget.Name], target); err != nil {
+			return errors.Wrapf(err, "failed to delete silence from AlertmanagerTarget %s", amTarget.Name)
+		}
+		deleted[amTarget.Name] = true
+	}
+
+	_, err = r.removeFromTargets(ctx, comment, silence.Status.Targets, deleted)
+	return err
+}
+
+// silencesForTarget returns a request for each Silence that selects amTarget or was synced to it.
+func (r *SilenceV2Reconciler) silencesForTarget(ctx context.Context, amTarget client.Object) []ctrl.Request {
+	silences := &v1alpha2.SilenceList{}
+	if err := r.client.List(ctx, silences); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list silences for AlertmanagerTarget", "target", amTarget.GetName())
+		return nil
+	}
+
+	var requests []ctrl.Request
+	for i := range silences.Items {
+		silence := &silences.Items[i]
+		if !r.selected(silence) {
+			continue
+		}
+		if selectsTarget(silence, amTarget) || slices.ContainsFunc(silence.Status.Targets, func(status v1alpha2.SilenceTargetStatus) bool {
+			return status.Name == amTarget.GetName()
+		}) {
+			requests = append(requests, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(silence)})
+		}
+	}
+	return requests
+}
+
+// targetEventHandler enqueues the silences of changed AlertmanagerTargets like its
+// EventHandler, and drops the client of deleted AlertmanagerTargets from the silence service.
+type targetEventHandler struct {
+	handler.EventHandler
+
+	silenceService *service.SilenceService
+}
+
+// Delete drops the client of the deleted AlertmanagerTarget before its silences are enqueued.
+func (h *targetEventHandler) Delete(ctx context.Context, e event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.silenceService.RemoveTarget(e.Object.GetName())
+	h.EventHandler.Delete(ctx, e, q)
+}
+
+// selected reports whether the silence passes the operator's silence and namespace selectors.
+func (r *SilenceV2Reconciler) selected(silence *v1alpha2.Silence) bool {
+	for _, p := range r.predicates {
+		if !p.Generic(event.GenericEvent{Object: silence}) {
+			return false
+		}
+	}
+	return true
+}
+
+// selectsTarget reports whether spec.targetRef or spec.targetSelector of the silence match amTarget.
+func selectsTarget(silence *v1alpha2.Silence, amTarget client.Object) bool {
+	if ref := silence.Spec.TargetRef; ref != nil {
+		return ref.Name == amTarget.GetName()
+	}
+	if silence.Spec.TargetSelector == nil {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(silence.Spec.TargetSelector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(amTarget.GetLabels()))
+}
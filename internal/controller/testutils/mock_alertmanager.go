@@ -2,10 +2,14 @@ package testutils
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/giantswarm/silence-operator/pkg/alertmanager"
 	"github.com/giantswarm/silence-operator/pkg/config"
@@ -15,7 +19,27 @@ import (
 type MockAlertmanagerServer struct {
 	server   *httptest.Server
 	silences map[string]*alertmanager.Silence
-	mu       sync.RWMutex
+	alerts   []alertmanager.Alert
+	// listRequests counts the requests listing silences
+	listRequests int
+	// listAlertsRequests counts the requests listing alerts
+	listAlertsRequests int
+	// rejectReason makes creating silences fail with 400 Bad Request when set
+	rejectReason string
+	mu           sync.RWMutex
+}
+
+// MockSilenceID returns the ID the mock server assigns to new silences with the given
+// comment. It is derived from the instance and UID of the identity, so it is a valid URL
+// path segment.
+func MockSilenceID(comment string) string {
+	if id, ok := alertmanager.ParseIdentity(comment); ok {
+		if id.Instance != "" {
+			return "mock-id-" + string(id.Instance) + "-" + string(id.UID)
+		}
+		return "mock-id-" + string(id.UID)
+	}
+	return "mock-id-" + alertmanager.CommentIdentity(comment)
 }
 
 // NewMockAlertmanagerServer creates a new mock Alertmanager server
@@ -31,7 +55,7 @@ func NewMockAlertmanagerServer() *MockAlertmanagerServer {
 	mux.HandleFunc("/api/v2/silences", func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodGet:
-			mock.handleListSilences(w)
+			mock.handleListSilences(w, r)
 		case http.MethodPost:
 			mock.handleCreateSilence(w, r)
 		default:
@@ -39,11 +63,23 @@ func NewMockAlertmanagerServer() *MockAlertmanagerServer {
 		}
 	})
 
-	// Handle DELETE /api/v2/silence/{id} - delete silence by ID
+	// Handle GET /api/v2/alerts - list alerts
+	mux.HandleFunc("/api/v2/alerts", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			mock.handleListAlerts(w)
+		} else {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// Handle GET and DELETE /api/v2/silence/{id} - get or delete silence by ID
 	mux.HandleFunc("/api/v2/silence/", func(w http.ResponseWriter, r *http.Request) {
-		if r.Method == http.MethodDelete {
+		switch r.Method {
+		case http.MethodGet:
+			mock.handleGetSilence(w, r)
+		case http.MethodDelete:
 			mock.handleDeleteSilence(w, r)
-		} else {
+		default:
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		}
 	})
@@ -72,11 +108,29 @@ func (m *MockAlertmanagerServer) AddSilence(silence *alertmanager.Silence) {
 	defer m.mu.Unlock()
 
 	if silence.ID == "" {
-		silence.ID = "mock-id-" + silence.Comment
+		silence.ID = MockSilenceID(silence.Comment)
 	}
 	m.silences[silence.ID] = silence
 }
 
+// RemoveSilence removes a silence from the mock server's state, as if it was deleted
+// outside of the operator
+func (m *MockAlertmanagerServer) RemoveSilence(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.silences, id)
+}
+
+// RejectSilences makes the mock server reject new and updated silences as invalid with
+// the given reason, like Alertmanager does for silences it cannot parse
+func (m *MockAlertmanagerServer) RejectSilences(reason string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.rejectReason = reason
+}
+
 // GetSilences returns all silences from the mock server
 func (m *MockAlertmanagerServer) GetSilences() []*alertmanager.Silence {
 	m.mu.RLock()
@@ -89,15 +143,35 @@ func (m *MockAlertmanagerServer) GetSilences() []*alertmanager.Silence {
 	return silences
 }
 
-func (m *MockAlertmanagerServer) handleListSilences(w http.ResponseWriter) {
+// ListSilencesRequests returns the number of requests listing silences received so far
+func (m *MockAlertmanagerServer) ListSilencesRequests() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.listRequests
+}
+
+// ListAlertsRequests returns the number of requests listing alerts received so far
+func (m *MockAlertmanagerServer) ListAlertsRequests() int {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
+	return m.listAlertsRequests
+}
+
+func (m *MockAlertmanagerServer) handleListSilences(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.listRequests++
+
 	var silences []alertmanager.Silence
 	for _, silence := range m.silences {
 		// Only return non-expired silences (like the real Alertmanager)
 		if silence.Status == nil || silence.Status.State != alertmanager.SilenceStateExpired {
-			silences = append(silences, *silence)
+			if matchesFilter(silence, r.URL.Query()["filter"]) {
+				silences = append(silences, *silence)
+			}
 		}
 	}
 
@@ -108,6 +182,35 @@ func (m *MockAlertmanagerServer) handleListSilences(w http.ResponseWriter) {
 	}
 }
 
+// AddAlert adds a firing alert with the given labels to the mock server's state
+func (m *MockAlertmanagerServer) AddAlert(labels map[string]string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.alerts = append(m.alerts, alertmanager.Alert{
+		Fingerprint: fmt.Sprintf("mock-fingerprint-%d", len(m.alerts)),
+		Labels:      labels,
+		Status:      &alertmanager.AlertStatus{State: "active"},
+	})
+}
+
+func (m *MockAlertmanagerServer) handleListAlerts(w http.ResponseWriter) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.listAlertsRequests++
+
+	alerts := m.alerts
+	if alerts == nil {
+		alerts = []alertmanager.Alert{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(alerts); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
 func (m *MockAlertmanagerServer) handleCreateSilence(w http.ResponseWriter, r *http.Request) {
 	var silence alertmanager.Silence
 	if err := json.NewDecoder(r.Body).Decode(&silence); err != nil {
@@ -118,14 +221,20 @@ func (m *MockAlertmanagerServer) handleCreateSilence(w http.ResponseWriter, r *h
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	if m.rejectReason != "" {
+		http.Error(w, m.rejectReason, http.StatusBadRequest)
+		return
+	}
+
 	// Generate ID if not provided (new silence)
 	if silence.ID == "" {
-		silence.ID = "mock-id-" + silence.Comment
+		silence.ID = MockSilenceID(silence.Comment)
 	}
 
 	if silence.Status == nil {
 		silence.Status = &alertmanager.Status{State: "active"}
 	}
+	silence.UpdatedAt = time.Now()
 
 	m.silences[silence.ID] = &silence
 
@@ -137,6 +246,42 @@ func (m *MockAlertmanagerServer) handleCreateSilence(w http.ResponseWriter, r *h
 	}
 }
 
+// matchesFilter reports whether the silence has a matcher with the name and value of each
+// name="value" filter, like the Alertmanager silences API.
+func matchesFilter(silence *alertmanager.Silence, filters []string) bool {
+	for _, filter := range filters {
+		name, quoted, _ := strings.Cut(filter, "=")
+		value, err := strconv.Unquote(quoted)
+		if err != nil {
+			return false
+		}
+		if !slices.ContainsFunc(silence.Matchers, func(m alertmanager.Matcher) bool {
+			return m.Name == name && m.Value == value
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *MockAlertmanagerServer) handleGetSilence(w http.ResponseWriter, r *http.Request) {
+	silenceID := strings.TrimPrefix(r.URL.Path, "/api/v2/silence/")
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	silence, exists := m.silences[silenceID]
+	if !exists {
+		http.Error(w, "Silence not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(silence); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
 func (m *MockAlertmanagerServer) handleDeleteSilence(w http.ResponseWriter, r *http.Request) {
 	// Extract silence ID from URL path
 	path := strings.TrimPrefix(r.URL.Path, "/api/v2/silence/")
@@ -153,3 +298,8 @@ func (m *MockAlertmanagerServer) handleDeleteSilence(w http.ResponseWriter, r *h
 	delete(m.silences, silenceID)
 	w.WriteHeader(http.StatusOK)
 }
+
+// URL returns the address of the mock server
+func (m *MockAlertmanagerServer) URL() string {
+	return m.server.URL
+}
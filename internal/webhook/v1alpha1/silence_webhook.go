@@ -0,0 +1,95 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/giantswarm/silence-operator/api/v1alpha1"
+	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+	"github.com/giantswarm/silence-operator/pkg/matcher"
+)
+
+var silencelog = logf.Log.WithName("silence-v1alpha1-resource")
+
+// SetupSilenceWebhookWithManager registers the webhook for Silence in the manager.
+func SetupSilenceWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &v1alpha1.Silence{}).
+		WithValidator(&SilenceCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-monitoring-giantswarm-io-v1alpha1-silence,mutating=false,failurePolicy=fail,sideEffects=None,groups=monitoring.giantswarm.io,resources=silences,verbs=create;update,versions=v1alpha1,name=vsilence-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// SilenceCustomValidator rejects v1alpha1 Silences that Alertmanager would refuse
+// or that the reconciler would fail to convert.
+type SilenceCustomValidator struct{}
+
+var _ admission.Validator[*v1alpha1.Silence] = &SilenceCustomValidator{}
+
+// ValidateCreate implements admission.Validator.
+func (v *SilenceCustomValidator) ValidateCreate(_ context.Context, silence *v1alpha1.Silence) (admission.Warnings, error) {
+	silencelog.V(1).Info("validating create", "name", silence.GetName())
+
+	return nil, validateSilence(silence)
+}
+
+// ValidateUpdate implements admission.Validator.
+// Updates that leave the matchers and the valid-until annotation untouched are always
+// allowed, so that finalizers can be removed from Silences created before the webhook.
+func (v *SilenceCustomValidator) ValidateUpdate(_ context.Context, oldSilence, newSilence *v1alpha1.Silence) (admission.Warnings, error) {
+	silencelog.V(1).Info("validating update", "name", newSilence.GetName())
+
+	if !newSilence.GetDeletionTimestamp().IsZero() {
+		return nil, nil
+	}
+	if equality.Semantic.DeepEqual(oldSilence.Spec, newSilence.Spec) &&
+		oldSilence.GetAnnotations()[alertmanager.ValidUntilAnnotationName] == newSilence.GetAnnotations()[alertmanager.ValidUntilAnnotationName] {
+		return nil, nil
+	}
+
+	return nil, validateSilence(newSilence)
+}
+
+// ValidateDelete implements admission.Validator.
+func (v *SilenceCustomValidator) ValidateDelete(_ context.Context, _ *v1alpha1.Silence) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateSilence(silence *v1alpha1.Silence) error {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, matcher.Validate(matcher.ConvertV1alpha1(silence.Spec.Matchers), field.NewPath("spec", "matchers"))...)
+
+	if _, err := alertmanager.SilenceEndsAt(silence); err != nil {
+		value := silence.GetAnnotations()[alertmanager.ValidUntilAnnotationName]
+		allErrs = append(allErrs, field.Invalid(field.NewPath("metadata", "annotations").Key(alertmanager.ValidUntilAnnotationName), value, err.Error()))
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(v1alpha1.GroupVersion.WithKind("Silence").GroupKind(), silence.GetName(), allErrs)
+}
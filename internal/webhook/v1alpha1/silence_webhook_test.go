@@ -0,0 +1,78 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/silence-operator/api/v1alpha1"
+)
+
+func TestValidateCreate(t *testing.T) {
+	tests := []struct {
+		name        string
+		matchers    []v1alpha1.Matcher
+		annotations map[string]string
+		wantErr     string
+	}{
+		{
+			name:        "valid silence",
+			matchers:    []v1alpha1.Matcher{{Name: "alertname", Value: "Foo"}, {Name: "instance", Value: ".*prod.*", IsRegex: true}},
+			annotations: map[string]string{"valid-until": "2030-01-02"},
+		},
+		{
+			name:     "conflicting matchers",
+			matchers: []v1alpha1.Matcher{{Name: "alertname", Value: "Foo"}, {Name: "alertname", Value: "Bar"}},
+			wantErr:  "conflicts with",
+		},
+		{
+			name:     "duplicate matchers",
+			matchers: []v1alpha1.Matcher{{Name: "alertname", Value: "Foo"}, {Name: "alertname", Value: "Foo"}},
+			wantErr:  "Duplicate value",
+		},
+		{
+			name:        "invalid valid-until annotation",
+			matchers:    []v1alpha1.Matcher{{Name: "alertname", Value: "Foo"}},
+			annotations: map[string]string{"valid-until": "02/01/2030"},
+			wantErr:     "metadata.annotations[valid-until]",
+		},
+	}
+
+	validator := &SilenceCustomValidator{}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			silence := &v1alpha1.Silence{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-silence", Annotations: tc.annotations},
+				Spec:       v1alpha1.SilenceSpec{Matchers: tc.matchers},
+			}
+			_, err := validator.ValidateCreate(context.Background(), silence)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.True(t, apierrors.IsInvalid(err))
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/giantswarm/silence-operator/api/v1alpha2"
+	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+	"github.com/giantswarm/silence-operator/pkg/approval"
+	"github.com/giantswarm/silence-operator/pkg/config"
+	"github.com/giantswarm/silence-operator/pkg/matcher"
+	"github.com/giantswarm/silence-operator/pkg/service"
+	"github.com/giantswarm/silence-operator/pkg/tenancy"
+)
+
+var silencelog = logf.Log.WithName("silence-v1alpha2-resource")
+
+// previewTimeout bounds the time spent previewing the alerts matched by a new silence,
+// so that an unreachable Alertmanager does not delay admission.
+const previewTimeout = 3 * time.Second
+
+// SetupSilenceWebhookWithManager registers the webhook for Silence in the manager.
+// New silences are previewed against the alerts firing in the default Alertmanager.
+func SetupSilenceWebhookWithManager(mgr ctrl.Manager, silenceService *service.SilenceService, tenancyHelper *tenancy.Helper, cfg config.Config) error {
+	return ctrl.NewWebhookManagedBy(mgr, &v1alpha2.Silence{}).
+		WithDefaulter(&SilenceCustomDefaulter{tenancyHelper: tenancyHelper}).
+		WithValidator(&SilenceCustomValidator{
+			silenceService:   silenceService,
+			tenancyHelper:    tenancyHelper,
+			warningThreshold: cfg.MatchedAlertsWarningThreshold,
+		}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-observability-giantswarm-io-v1alpha2-silence,mutating=true,failurePolicy=fail,sideEffects=None,groups=observability.giantswarm.io,resources=silences,verbs=create;update,versions=v1alpha2,name=msilence-v1alpha2.kb.io,admissionReviewVersions=v1
+
+// SilenceCustomDefaulter records who created and who approved a v1alpha2 Silence in
+// the approval annotations, so that users cannot approve their own silences.
+type SilenceCustomDefaulter struct {
+	tenancyHelper *tenancy.Helper
+}
+
+var _ admission.Defaulter[*v1alpha2.Silence] = &SilenceCustomDefaulter{}
+
+// Default implements admission.Defaulter.
+// On create, the creator is recorded and any approval is dropped. On update, the creator
+// is kept, an added or changed approval is attributed to the requesting user and a
+// change of the Alertmanager silence drops the approval, as the changed silence has not been
+// approved yet, see silenceChanged.
+func (d *SilenceCustomDefaulter) Default(ctx context.Context, silence *v1alpha2.Silence) error {
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	annotations := silence.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	user := req.UserInfo.Username
+
+	switch req.Operation {
+	case admissionv1.Create:
+		silencelog.V(1).Info("defaulting create", "namespace", silence.GetNamespace(), "name", silence.GetName())
+		annotations[approval.CreatedByAnnotation] = user
+		delete(annotations, approval.ApprovedByAnnotation)
+	case admissionv1.Update:
+		silencelog.V(1).Info("defaulting update", "namespace", silence.GetNamespace(), "name", silence.GetName())
+		oldSilence := &v1alpha2.Silence{}
+		if err := json.Unmarshal(req.OldObject.Raw, oldSilence); err != nil {
+			return errors.Wrap(err, "failed to decode old Silence")
+		}
+		oldAnnotations := oldSilence.GetAnnotations()
+
+		if createdBy, ok := oldAnnotations[approval.CreatedByAnnotation]; ok {
+			annotations[approval.CreatedByAnnotation] = createdBy
+		} else {
+			delete(annotations, approval.CreatedByAnnotation)
+		}
+
+		switch {
+		case silenceChanged(d.tenancyHelper, oldSilence, silence):
+			delete(annotations, approval.ApprovedByAnnotation)
+		case annotations[approval.ApprovedByAnnotation] != "" && annotations[approval.ApprovedByAnnotation] != oldAnnotations[approval.ApprovedByAnnotation]:
+			annotations[approval.ApprovedByAnnotation] = user
+		}
+	default:
+		return nil
+	}
+
+	silence.SetAnnotations(annotations)
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-observability-giantswarm-io-v1alpha2-silence,mutating=false,failurePolicy=fail,sideEffects=None,groups=observability.giantswarm.io,resources=silences,verbs=create;update,versions=v1alpha2,name=vsilence-v1alpha2.kb.io,admissionReviewVersions=v1
+
+// SilenceCustomValidator rejects v1alpha2 Silences that Alertmanager would refuse
+// or that the reconciler would fail to convert, and warns about new silences that
+// match no or many firing alerts.
+type SilenceCustomValidator struct {
+	// silenceService previews the alerts matched by new silences. No preview is made
+	// when it is nil.
+	silenceService *service.SilenceService
+	tenancyHelper  *tenancy.Helper
+	// warningThreshold is the number of matched alerts from which a warning is returned.
+	// Zero disables the warning.
+	warningThreshold int
+}
+
+var _ admission.Validator[*v1alpha2.Silence] = &SilenceCustomValidator{}
+
+// ValidateCreate implements admission.Validator.
+func (v *SilenceCustomValidator) ValidateCreate(ctx context.Context, silence *v1alpha2.Silence) (admission.Warnings, error) {
+	silencelog.V(1).Info("validating create", "namespace", silence.GetNamespace(), "name", silence.GetName())
+
+	if err := validateSilence(silence); err != nil {
+		return nil, err
+	}
+
+	return v.previewWarnings(ctx, silence), nil
+}
+
+// previewWarnings warns when the silence matches no firing alert, which often hints at a
+// typo in the matchers, or at least warningThreshold alerts. Silences routed to
+// AlertmanagerTargets are not previewed.
+func (v *SilenceCustomValidator) previewWarnings(ctx context.Context, silence *v1alpha2.Silence) admission.Warnings {
+	if v.silenceService == nil || silence.Spec.TargetRef != nil || silence.Spec.TargetSelector != nil {
+		return nil
+	}
+
+	matchers, err := matcher.ConvertV1alpha2(silence.Spec.Matchers)
+	if err != nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, previewTimeout)
+	defer cancel()
+
+	target := v.silenceService.DefaultTarget(v.tenancyHelper.ExtractTenant(silence))
+	alerts, err := v.silenceService.MatchingAlerts(ctx, matchers, target)
+	if err != nil {
+		silencelog.Error(err, "failed to preview matched alerts", "namespace", silence.GetNamespace(), "name", silence.GetName())
+		return admission.Warnings{fmt.Sprintf("could not preview the alerts matched by this silence: %v", err)}
+	}
+
+	switch {
+	case len(alerts) == 0:
+		return admission.Warnings{"silence does not match any alert that is currently firing, check the matchers for typos"}
+	case v.warningThreshold > 0 && len(alerts) >= v.warningThreshold:
+		return admission.Warnings{fmt.Sprintf("silence matches %d alerts that are currently firing", len(alerts))}
+	default:
+		return nil
+	}
+}
+
+// ValidateUpdate implements admission.Validator.
+// Updates that leave the Alertmanager silence and the approval annotation untouched are
+// always allowed, so that finalizers can be removed from Silences created before the webhook.
+// Updates that change the Alertmanager silence cannot keep its approval.
+func (v *SilenceCustomValidator) ValidateUpdate(_ context.Context, oldSilence, newSilence *v1alpha2.Silence) (admission.Warnings, error) {
+	silencelog.V(1).Info("validating update", "namespace", newSilence.GetNamespace(), "name", newSilence.GetName())
+
+	if !newSilence.GetDeletionTimestamp().IsZero() {
+		return nil, nil
+	}
+	changed := silenceChanged(v.tenancyHelper, oldSilence, newSilence)
+	approvedBy := newSilence.GetAnnotations()[approval.ApprovedByAnnotation]
+	if !changed && oldSilence.GetAnnotations()[approval.ApprovedByAnnotation] == approvedBy {
+		return nil, nil
+	}
+
+	if err := validateSilence(newSilence); err != nil {
+		return nil, err
+	}
+	if changed && approvedBy != "" && approvedBy == oldSilence.GetAnnotations()[approval.ApprovedByAnnotation] {
+		return nil, apierrors.NewInvalid(v1alpha2.GroupVersion.WithKind("Silence").GroupKind(), newSilence.GetName(), field.ErrorList{
+			field.Forbidden(field.NewPath("metadata", "annotations").Key(approval.ApprovedByAnnotation), "a changed silence must be approved again"),
+		})
+	}
+	return nil, nil
+}
+
+// silenceChanged reports whether the Alertmanager silence of newSilence may differ from the
+// one of oldSilence. Besides the spec, the reconciler reads the valid-until annotation for
+// the end time and the tenancy label for the tenant.
+func silenceChanged(tenancyHelper *tenancy.Helper, oldSilence, newSilence *v1alpha2.Silence) bool {
+	return !equality.Semantic.DeepEqual(oldSilence.Spec, newSilence.Spec) ||
+		oldSilence.GetAnnotations()[alertmanager.ValidUntilAnnotationName] != newSilence.GetAnnotations()[alertmanager.ValidUntilAnnotationName] ||
+		tenancyHelper.ExtractTenant(oldSilence) != tenancyHelper.ExtractTenant(newSilence)
+}
+
+// ValidateDelete implements admission.Validator.
+func (v *SilenceCustomValidator) ValidateDelete(_ context.Context, _ *v1alpha2.Silence) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateSilence(silence *v1alpha2.Silence) error {
+	var allErrs field.ErrorList
+
+	matchersPath := field.NewPath("spec", "matchers")
+	matchers, err := matcher.ConvertV1alpha2(silence.Spec.Matchers)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(matchersPath, silence.Spec.Matchers, err.Error()))
+	} else {
+		allErrs = append(allErrs, matcher.Validate(matchers, matchersPath)...)
+	}
+
+	if silence.Spec.Schedule != nil {
+		if _, _, err := silence.Spec.Schedule.Window(time.Now()); err != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "schedule"), silence.Spec.Schedule, err.Error()))
+		}
+	}
+
+	if silence.Spec.TargetSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(silence.Spec.TargetSelector); err != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "targetSelector"), silence.Spec.TargetSelector, err.Error()))
+		}
+	}
+
+	// The valid-until annotation is only read when no explicit end is configured.
+	if silence.Spec.Schedule == nil && silence.Spec.EndsAt == nil && silence.Spec.Duration == nil {
+		if _, err := alertmanager.SilenceEndsAt(silence); err != nil {
+			value := silence.GetAnnotations()[alertmanager.ValidUntilAnnotationName]
+			allErrs = append(allErrs, field.Invalid(field.NewPath("metadata", "annotations").Key(alertmanager.ValidUntilAnnotationName), value, err.Error()))
+		}
+	}
+
+	annotations := silence.GetAnnotations()
+	if approvedBy := annotations[approval.ApprovedByAnnotation]; approvedBy != "" && approvedBy == annotations[approval.CreatedByAnnotation] {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("metadata", "annotations").Key(approval.ApprovedByAnnotation), "a silence cannot be approved by its creator"))
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(v1alpha2.GroupVersion.WithKind("Silence").GroupKind(), silence.GetName(), allErrs)
+}
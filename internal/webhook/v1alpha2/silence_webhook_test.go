@@ -0,0 +1,388 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/giantswarm/silence-operator/api/v1alpha2"
+	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+	"github.com/giantswarm/silence-operator/pkg/approval"
+	"github.com/giantswarm/silence-operator/pkg/config"
+	"github.com/giantswarm/silence-operator/pkg/service"
+	"github.com/giantswarm/silence-operator/pkg/tenancy"
+)
+
+func newSilence(matchers ...v1alpha2.SilenceMatcher) *v1alpha2.Silence {
+	return &v1alpha2.Silence{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "test-silence",
+			Namespace:         "default",
+			CreationTimestamp: metav1.Now(),
+		},
+		Spec: v1alpha2.SilenceSpec{Matchers: matchers},
+	}
+}
+
+func TestValidateCreate(t *testing.T) {
+	duration := v1alpha2.SilenceDuration("2h")
+
+	tests := []struct {
+		name    string
+		silence func() *v1alpha2.Silence
+		wantErr string
+	}{
+		{
+			name: "valid silence",
+			silence: func() *v1alpha2.Silence {
+				return newSilence(
+					v1alpha2.SilenceMatcher{Name: "alertname", Value: "Foo"},
+					v1alpha2.SilenceMatcher{Name: "instance", Value: ".*prod.*", MatchType: v1alpha2.MatchRegexMatch},
+				)
+			},
+		},
+		{
+			name: "invalid regex",
+			silence: func() *v1alpha2.Silence {
+				return newSilence(v1alpha2.SilenceMatcher{Name: "instance", Value: "prod(", MatchType: v1alpha2.MatchRegexMatch})
+			},
+			wantErr: "spec.matchers[0].value",
+		},
+		{
+			name: "only negative matchers",
+			silence: func() *v1alpha2.Silence {
+				return newSilence(v1alpha2.SilenceMatcher{Name: "team", Value: "a", MatchType: v1alpha2.MatchNotEqual})
+			},
+			wantErr: "at least one matcher must not match the empty string",
+		},
+		{
+			name: "invalid valid-until annotation",
+			silence: func() *v1alpha2.Silence {
+				s := newSilence(v1alpha2.SilenceMatcher{Name: "alertname", Value: "Foo"})
+				s.Annotations = map[string]string{"valid-until": "next tuesday"}
+				return s
+			},
+			wantErr: "metadata.annotations[valid-until]",
+		},
+		{
+			name: "valid-until annotation is ignored when duration is set",
+			silence: func() *v1alpha2.Silence {
+				s := newSilence(v1alpha2.SilenceMatcher{Name: "alertname", Value: "Foo"})
+				s.Annotations = map[string]string{"valid-until": "next tuesday"}
+				s.Spec.Duration = &duration
+				return s
+			},
+		},
+		{
+			name: "invalid schedule",
+			silence: func() *v1alpha2.Silence {
+				s := newSilence(v1alpha2.SilenceMatcher{Name: "alertname", Value: "Foo"})
+				s.Spec.Schedule = &v1alpha2.SilenceSchedule{Cron: "0 22 * * *", TimeZone: "Mars/Olympus_Mons", Duration: "8h"}
+				return s
+			},
+			wantErr: "spec.schedule",
+		},
+		{
+			name: "invalid target selector",
+			silence: func() *v1alpha2.Silence {
+				s := newSilence(v1alpha2.SilenceMatcher{Name: "alertname", Value: "Foo"})
+				s.Spec.TargetSelector = &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "env", Operator: metav1.LabelSelectorOpIn}},
+				}
+				return s
+			},
+			wantErr: "spec.targetSelector",
+		},
+		{
+			name: "approved by its creator",
+			silence: func() *v1alpha2.Silence {
+				s := newSilence(v1alpha2.SilenceMatcher{Name: "alertname", Value: "Foo"})
+				s.Annotations = map[string]string{
+					approval.CreatedByAnnotation:  "alice",
+					approval.ApprovedByAnnotation: "alice",
+				}
+				return s
+			},
+			wantErr: "a silence cannot be approved by its creator",
+		},
+	}
+
+	validator := &SilenceCustomValidator{}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := validator.ValidateCreate(context.Background(), tc.silence())
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.True(t, apierrors.IsInvalid(err))
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
+
+func TestValidateCreatePreviewWarnings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`[
+			{"fingerprint": "1", "labels": {"alertname": "Foo", "cluster": "a"}},
+			{"fingerprint": "2", "labels": {"alertname": "Foo", "cluster": "b"}},
+			{"fingerprint": "3", "labels": {"alertname": "Bar", "cluster": "a"}}
+		]`))
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	am, err := alertmanager.New(config.Config{Address: server.URL})
+	require.NoError(t, err)
+
+	validator := &SilenceCustomValidator{
+		silenceService:   service.NewSilenceService(am, 0),
+		tenancyHelper:    tenancy.NewHelper(config.Config{}),
+		warningThreshold: 2,
+	}
+
+	tests := []struct {
+		name        string
+		silence     *v1alpha2.Silence
+		wantWarning string
+	}{
+		{
+			name:    "matches a few alerts",
+			silence: newSilence(v1alpha2.SilenceMatcher{Name: "alertname", Value: "Bar"}),
+		},
+		{
+			name:        "matches no alert",
+			silence:     newSilence(v1alpha2.SilenceMatcher{Name: "alertname", Value: "Fooo"}),
+			wantWarning: "does not match any alert",
+		},
+		{
+			name:        "matches many alerts",
+			silence:     newSilence(v1alpha2.SilenceMatcher{Name: "alertname", Value: "Foo"}),
+			wantWarning: "matches 2 alerts",
+		},
+		{
+			name: "routed silences are not previewed",
+			silence: func() *v1alpha2.Silence {
+				s := newSilence(v1alpha2.SilenceMatcher{Name: "alertname", Value: "Fooo"})
+				s.Spec.TargetRef = &v1alpha2.AlertmanagerTargetReference{Name: "production"}
+				return s
+			}(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			warnings, err := validator.ValidateCreate(context.Background(), tc.silence)
+			require.NoError(t, err)
+			if tc.wantWarning == "" {
+				assert.Empty(t, warnings)
+				return
+			}
+			require.Len(t, warnings, 1)
+			assert.Contains(t, warnings[0], tc.wantWarning)
+		})
+	}
+}
+
+// testTenancyHelper reads the tenant from the tenant label.
+var testTenancyHelper = tenancy.NewHelper(config.Config{TenancyEnabled: true, TenancyLabelKey: "tenant", TenancyDefaultTenant: "default"})
+
+func TestValidateUpdate(t *testing.T) {
+	validator := &SilenceCustomValidator{tenancyHelper: testTenancyHelper}
+
+	// An existing invalid silence must still accept metadata-only updates and deletion.
+	oldSilence := newSilence(v1alpha2.SilenceMatcher{Name: "team", Value: "a", MatchType: v1alpha2.MatchNotEqual})
+	newSilence := oldSilence.DeepCopy()
+	newSilence.Finalizers = nil
+	_, err := validator.ValidateUpdate(context.Background(), oldSilence, newSilence)
+	assert.NoError(t, err)
+
+	newSilence.Spec.Matchers[0].Value = "b"
+	_, err = validator.ValidateUpdate(context.Background(), oldSilence, newSilence)
+	assert.Error(t, err)
+
+	now := metav1.NewTime(time.Now())
+	newSilence.DeletionTimestamp = &now
+	_, err = validator.ValidateUpdate(context.Background(), oldSilence, newSilence)
+	assert.NoError(t, err)
+}
+
+func TestValidateUpdateApproval(t *testing.T) {
+	validator := &SilenceCustomValidator{tenancyHelper: testTenancyHelper}
+
+	approved := newSilence(v1alpha2.SilenceMatcher{Name: "alertname", Value: "Foo"})
+	approved.Annotations = map[string]string{
+		approval.CreatedByAnnotation:          "bob",
+		approval.ApprovedByAnnotation:         "carol",
+		alertmanager.ValidUntilAnnotationName: "2030-01-01",
+	}
+
+	updates := map[string]func(*v1alpha2.Silence){
+		"valid-until changed": func(s *v1alpha2.Silence) {
+			s.Annotations[alertmanager.ValidUntilAnnotationName] = "2126-01-01"
+		},
+		"valid-until removed": func(s *v1alpha2.Silence) {
+			delete(s.Annotations, alertmanager.ValidUntilAnnotationName)
+		},
+		"tenant changed": func(s *v1alpha2.Silence) {
+			s.Labels = map[string]string{"tenant": "other"}
+		},
+	}
+	for name, update := range updates {
+		updated := approved.DeepCopy()
+		update(updated)
+		_, err := validator.ValidateUpdate(context.Background(), approved, updated)
+		assert.ErrorContains(t, err, "must be approved again", name)
+
+		delete(updated.Annotations, approval.ApprovedByAnnotation)
+		_, err = validator.ValidateUpdate(context.Background(), approved, updated)
+		assert.NoError(t, err, name)
+	}
+
+	// Labels other than the tenancy label do not change the silence.
+	updated := approved.DeepCopy()
+	updated.Labels = map[string]string{"team": "a"}
+	_, err := validator.ValidateUpdate(context.Background(), approved, updated)
+	assert.NoError(t, err)
+}
+
+func TestDefault(t *testing.T) {
+	withAnnotations := func(s *v1alpha2.Silence, annotations map[string]string) *v1alpha2.Silence {
+		s.Annotations = annotations
+		return s
+	}
+	silence := func() *v1alpha2.Silence {
+		return newSilence(v1alpha2.SilenceMatcher{Name: "alertname", Value: "Foo"})
+	}
+
+	tests := []struct {
+		name            string
+		operation       admissionv1.Operation
+		oldSilence      *v1alpha2.Silence
+		silence         *v1alpha2.Silence
+		wantAnnotations map[string]string
+	}{
+		{
+			name:            "create records the creator and drops approvals",
+			operation:       admissionv1.Create,
+			silence:         withAnnotations(silence(), map[string]string{approval.CreatedByAnnotation: "mallory", approval.ApprovedByAnnotation: "bob"}),
+			wantAnnotations: map[string]string{approval.CreatedByAnnotation: "alice"},
+		},
+		{
+			name:            "approval is attributed to the requesting user",
+			operation:       admissionv1.Update,
+			oldSilence:      withAnnotations(silence(), map[string]string{approval.CreatedByAnnotation: "bob"}),
+			silence:         withAnnotations(silence(), map[string]string{approval.CreatedByAnnotation: "bob", approval.ApprovedByAnnotation: "carol"}),
+			wantAnnotations: map[string]string{approval.CreatedByAnnotation: "bob", approval.ApprovedByAnnotation: "alice"},
+		},
+		{
+			name:            "creator cannot be changed",
+			operation:       admissionv1.Update,
+			oldSilence:      withAnnotations(silence(), map[string]string{approval.CreatedByAnnotation: "bob"}),
+			silence:         withAnnotations(silence(), map[string]string{approval.CreatedByAnnotation: "mallory"}),
+			wantAnnotations: map[string]string{approval.CreatedByAnnotation: "bob"},
+		},
+		{
+			name:       "spec change drops the approval",
+			operation:  admissionv1.Update,
+			oldSilence: withAnnotations(silence(), map[string]string{approval.CreatedByAnnotation: "bob", approval.ApprovedByAnnotation: "carol"}),
+			silence: func() *v1alpha2.Silence {
+				s := withAnnotations(silence(), map[string]string{approval.CreatedByAnnotation: "bob", approval.ApprovedByAnnotation: "carol"})
+				s.Spec.Matchers[0].Value = ".*"
+				return s
+			}(),
+			wantAnnotations: map[string]string{approval.CreatedByAnnotation: "bob"},
+		},
+		{
+			name:            "valid-until change drops the approval",
+			operation:       admissionv1.Update,
+			oldSilence:      withAnnotations(silence(), map[string]string{approval.CreatedByAnnotation: "bob", approval.ApprovedByAnnotation: "carol", alertmanager.ValidUntilAnnotationName: "2030-01-01"}),
+			silence:         withAnnotations(silence(), map[string]string{approval.CreatedByAnnotation: "bob", approval.ApprovedByAnnotation: "carol", alertmanager.ValidUntilAnnotationName: "2126-01-01"}),
+			wantAnnotations: map[string]string{approval.CreatedByAnnotation: "bob", alertmanager.ValidUntilAnnotationName: "2126-01-01"},
+		},
+		{
+			name:            "valid-until removal drops the approval",
+			operation:       admissionv1.Update,
+			oldSilence:      withAnnotations(silence(), map[string]string{approval.CreatedByAnnotation: "bob", approval.ApprovedByAnnotation: "carol", alertmanager.ValidUntilAnnotationName: "2030-01-01"}),
+			silence:         withAnnotations(silence(), map[string]string{approval.CreatedByAnnotation: "bob", approval.ApprovedByAnnotation: "carol"}),
+			wantAnnotations: map[string]string{approval.CreatedByAnnotation: "bob"},
+		},
+		{
+			name:       "tenant change drops the approval",
+			operation:  admissionv1.Update,
+			oldSilence: withAnnotations(silence(), map[string]string{approval.CreatedByAnnotation: "bob", approval.ApprovedByAnnotation: "carol"}),
+			silence: func() *v1alpha2.Silence {
+				s := withAnnotations(silence(), map[string]string{approval.CreatedByAnnotation: "bob", approval.ApprovedByAnnotation: "carol"})
+				s.Labels = map[string]string{"tenant": "other"}
+				return s
+			}(),
+			wantAnnotations: map[string]string{approval.CreatedByAnnotation: "bob"},
+		},
+		{
+			name:       "unrelated label change keeps the approval",
+			operation:  admissionv1.Update,
+			oldSilence: withAnnotations(silence(), map[string]string{approval.CreatedByAnnotation: "bob", approval.ApprovedByAnnotation: "carol"}),
+			silence: func() *v1alpha2.Silence {
+				s := withAnnotations(silence(), map[string]string{approval.CreatedByAnnotation: "bob", approval.ApprovedByAnnotation: "carol"})
+				s.Labels = map[string]string{"team": "a"}
+				return s
+			}(),
+			wantAnnotations: map[string]string{approval.CreatedByAnnotation: "bob", approval.ApprovedByAnnotation: "carol"},
+		},
+		{
+			name:            "unrelated update keeps the approval",
+			operation:       admissionv1.Update,
+			oldSilence:      withAnnotations(silence(), map[string]string{approval.CreatedByAnnotation: "bob", approval.ApprovedByAnnotation: "carol"}),
+			silence:         withAnnotations(silence(), map[string]string{approval.CreatedByAnnotation: "bob", approval.ApprovedByAnnotation: "carol"}),
+			wantAnnotations: map[string]string{approval.CreatedByAnnotation: "bob", approval.ApprovedByAnnotation: "carol"},
+		},
+	}
+
+	defaulter := &SilenceCustomDefaulter{tenancyHelper: testTenancyHelper}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: tc.operation,
+				UserInfo:  authenticationv1.UserInfo{Username: "alice"},
+			}}
+			if tc.oldSilence != nil {
+				raw, err := json.Marshal(tc.oldSilence)
+				require.NoError(t, err)
+				req.OldObject = runtime.RawExtension{Raw: raw}
+			}
+
+			err := defaulter.Default(admission.NewContextWithRequest(context.Background(), req), tc.silence)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantAnnotations, tc.silence.Annotations)
+		})
+	}
+}
@@ -2,14 +2,18 @@ package alertmanager
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
+	promconfig "github.com/prometheus/common/config"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/giantswarm/silence-operator/pkg/config"
@@ -22,6 +26,7 @@ const (
 	// Define API paths as constants
 	apiV2SilencesPath = "/api/v2/silences"
 	apiV2SilencePath  = "/api/v2/silence"
+	apiV2AlertsPath   = "/api/v2/alerts"
 	// Define state constant
 	SilenceStateExpired = "expired"
 )
@@ -30,14 +35,19 @@ var (
 	ErrSilenceNotFound = errors.New("silence not found")
 )
 
-// Client defines the contract for alertmanager operations
+// Client defines the contract for alertmanager operations.
+// Silences are looked up by comment identity, so details rendered into the comment by
+// FormatComment may change without losing track of the silence.
+// Requests are canceled when ctx is done.
 type Client interface {
-	GetSilenceByComment(comment string, tenant string) (*Silence, error)
-	CreateSilence(s *Silence, tenant string) error
-	UpdateSilence(s *Silence, tenant string) error
-	DeleteSilenceByComment(comment string, tenant string) error
-	DeleteSilenceByID(id string, tenant string) error
-	ListSilences(tenant string) ([]Silence, error)
+	GetSilenceByID(ctx context.Context, id string, tenant string) (*Silence, error)
+	GetSilenceByComment(ctx context.Context, comment string, tenant string, filter ...Matcher) (*Silence, error)
+	CreateSilence(ctx context.Context, s *Silence, tenant string) (string, error)
+	UpdateSilence(ctx context.Context, s *Silence, tenant string) (string, error)
+	DeleteSilenceByComment(ctx context.Context, comment string, tenant string) error
+	DeleteSilenceByID(ctx context.Context, id string, tenant string) error
+	ListSilences(ctx context.Context, tenant string, filter ...Matcher) ([]Silence, error)
+	ListAlerts(ctx context.Context, tenant string) ([]Alert, error)
 }
 
 // Ensure Alertmanager implements Client
@@ -49,6 +59,8 @@ type Alertmanager struct {
 	token          string
 	tenantId       string
 	client         *http.Client
+	// maxRetries is how often idempotent requests are retried.
+	maxRetries int
 }
 
 func New(config config.Config) (*Alertmanager, error) {
@@ -56,23 +68,77 @@ func New(config config.Config) (*Alertmanager, error) {
 		return nil, errors.Errorf("%T.Address must not be empty", config)
 	}
 
+	httpClient := &http.Client{}
+	if config.HTTPClient != nil {
+		var err error
+		httpClient, err = promconfig.NewClientFromConfig(*config.HTTPClient, "alertmanager")
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create Alertmanager HTTP client")
+		}
+	}
+	httpClient.Timeout = config.RequestTimeout
+
 	return &Alertmanager{
 		address:        config.Address,
 		authentication: config.Authentication,
 		token:          config.BearerToken,
-		client:         http.DefaultClient,
+		client:         httpClient,
 		tenantId:       config.TenantId,
+		maxRetries:     config.MaxRetries,
 	}, nil
 }
 
-func (am *Alertmanager) GetSilenceByComment(comment string, tenant string) (*Silence, error) {
-	silences, err := am.ListSilences(tenant)
+// GetSilenceByID returns the silence with the given ID. Expired silences are reported as
+// ErrSilenceNotFound, as ListSilences omits them.
+func (am *Alertmanager) GetSilenceByID(ctx context.Context, id string, tenant string) (*Silence, error) {
+	endpoint := fmt.Sprintf("%s%s/%s", am.address, apiV2SilencePath, url.PathEscape(id))
+
+	req, err := am.NewRequest(ctx, http.MethodGet, endpoint, nil, tenant)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if am.authentication {
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", am.token))
+	}
+
+	resp, err := am.do(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer resp.Body.Close() //nolint: errcheck
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errors.WithMessagef(ErrSilenceNotFound, "failed to get silence %#q", id)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, "failed to get silence %#q", id)
+	}
+
+	var silence Silence
+	err = json.NewDecoder(resp.Body).Decode(&silence)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if silence.Status != nil && silence.Status.State == SilenceStateExpired {
+		return nil, errors.WithMessagef(ErrSilenceNotFound, "failed to get silence %#q, silence expired", id)
+	}
+
+	return &silence, nil
+}
+
+// GetSilenceByComment returns the silence whose comment has the same identity as comment,
+// created by the instance named by comment. filter restricts the silences that are scanned,
+// see ListSilences.
+func (am *Alertmanager) GetSilenceByComment(ctx context.Context, comment string, tenant string, filter ...Matcher) (*Silence, error) {
+	silences, err := am.ListSilences(ctx, tenant, filter...)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
 	for _, s := range silences {
-		if s.Comment == comment {
+		if SameIdentity(s.Comment, comment) && CommentInstance(comment).Owns(&s) {
 			return &s, nil
 		}
 	}
@@ -80,17 +146,19 @@ func (am *Alertmanager) GetSilenceByComment(comment string, tenant string) (*Sil
 	return nil, errors.WithMessagef(ErrSilenceNotFound, "failed to get silence with comment %#q", comment)
 }
 
-func (am *Alertmanager) CreateSilence(s *Silence, tenant string) error {
+// CreateSilence creates the silence, or updates it if s.ID is set, and returns the ID
+// reported by Alertmanager.
+func (am *Alertmanager) CreateSilence(ctx context.Context, s *Silence, tenant string) (string, error) {
 	endpoint := fmt.Sprintf("%s%s", am.address, apiV2SilencesPath)
 
 	jsonValues, err := json.Marshal(s)
 	if err != nil {
-		return errors.WithStack(err)
+		return "", errors.WithStack(err)
 	}
 
-	req, err := am.NewRequest(http.MethodPost, endpoint, bytes.NewBuffer(jsonValues), tenant)
+	req, err := am.NewRequest(ctx, http.MethodPost, endpoint, bytes.NewBuffer(jsonValues), tenant)
 	if err != nil {
-		return errors.WithStack(err)
+		return "", errors.WithStack(err)
 	}
 	req.Header.Add("Content-Type", "application/json")
 
@@ -98,47 +166,66 @@ func (am *Alertmanager) CreateSilence(s *Silence, tenant string) error {
 		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", am.token))
 	}
 
-	resp, err := am.client.Do(req)
+	resp, err := am.do(req)
 	if err != nil {
-		return errors.WithStack(err)
+		return "", errors.WithStack(err)
 	}
 	defer resp.Body.Close() //nolint: errcheck
 
-	if resp.StatusCode != 200 {
-		return errors.Errorf("failed to create/update silence %#q, expected code 200, got %d", s.Comment, resp.StatusCode)
+	if resp.StatusCode != http.StatusOK {
+		return "", newAPIError(resp, "failed to create/update silence %#q", s.Comment)
 	}
 
-	return nil
+	var body struct {
+		SilenceID string `json:"silenceID"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&body)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to decode response for silence %#q", s.Comment)
+	}
+
+	return body.SilenceID, nil
 }
 
-func (am *Alertmanager) UpdateSilence(s *Silence, tenant string) error {
+// UpdateSilence updates the silence with ID s.ID and returns the ID reported by
+// Alertmanager. Alertmanager may replace the silence with a new one, and thus a new ID,
+// when the update cannot be applied in place.
+func (am *Alertmanager) UpdateSilence(ctx context.Context, s *Silence, tenant string) (string, error) {
 	if s.ID == "" {
-		return errors.Errorf("failed to update silence %#q, missing ID", s.Comment)
+		return "", errors.Errorf("failed to update silence %#q, missing ID", s.Comment)
 	}
-	return am.CreateSilence(s, tenant)
+	return am.CreateSilence(ctx, s, tenant)
 }
 
-func (am *Alertmanager) DeleteSilenceByComment(comment string, tenant string) error {
-	silences, err := am.ListSilences(tenant)
+// DeleteSilenceByComment deletes the silence whose comment has the same identity as comment
+// and that was created by the operator instance named by comment, see CommentInstance.
+func (am *Alertmanager) DeleteSilenceByComment(ctx context.Context, comment string, tenant string) error {
+	silences, err := am.ListSilences(ctx, tenant)
 	if err != nil {
 		return errors.WithStack(err)
 	}
 
 	for _, s := range silences {
-		if s.Comment == comment && s.CreatedBy == CreatedBy {
-			return am.DeleteSilenceByID(s.ID, tenant)
+		if SameIdentity(s.Comment, comment) && CommentInstance(comment).Owns(&s) {
+			return am.DeleteSilenceByID(ctx, s.ID, tenant)
 		}
 	}
 
 	return errors.WithMessagef(ErrSilenceNotFound, "failed to delete silence by comment %#q", comment)
 }
 
-func (am *Alertmanager) ListSilences(tenant string) ([]Silence, error) {
+// ListSilences returns the silences that have not expired. When filter is given, only
+// silences with an equal matcher for each of its matchers are returned, using the filter
+// query parameter of Alertmanager. Matchers are compared by name and value only.
+func (am *Alertmanager) ListSilences(ctx context.Context, tenant string, filter ...Matcher) ([]Silence, error) {
 	endpoint := fmt.Sprintf("%s%s", am.address, apiV2SilencesPath)
+	if query := filterQuery(filter); query != "" {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, query)
+	}
 
 	var silences []Silence
 
-	req, err := am.NewRequest(http.MethodGet, endpoint, nil, tenant)
+	req, err := am.NewRequest(ctx, http.MethodGet, endpoint, nil, tenant)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -147,12 +234,16 @@ func (am *Alertmanager) ListSilences(tenant string) ([]Silence, error) {
 		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", am.token))
 	}
 
-	resp, err := am.client.Do(req)
+	resp, err := am.do(req)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 	defer resp.Body.Close() //nolint: errcheck
 
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, "failed to list silences")
+	}
+
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, errors.WithStack(err)
@@ -173,10 +264,61 @@ func (am *Alertmanager) ListSilences(tenant string) ([]Silence, error) {
 	return filteredSilences, nil
 }
 
-func (am *Alertmanager) DeleteSilenceByID(id string, tenant string) error {
+// filterQuery encodes matchers as filter query parameters. Matchers on label names the
+// filter syntax does not support unquoted are skipped, which only widens the result.
+func filterQuery(matchers []Matcher) string {
+	values := url.Values{}
+	for _, m := range matchers {
+		if !labelNameRegexp.MatchString(m.Name) {
+			continue
+		}
+		values.Add("filter", fmt.Sprintf("%s=\"%s\"", m.Name, filterValueEscaper.Replace(m.Value)))
+	}
+	return values.Encode()
+}
+
+var (
+	labelNameRegexp    = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	filterValueEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+// ListAlerts returns the alerts currently known to Alertmanager, including silenced and
+// inhibited ones.
+func (am *Alertmanager) ListAlerts(ctx context.Context, tenant string) ([]Alert, error) {
+	endpoint := fmt.Sprintf("%s%s", am.address, apiV2AlertsPath)
+
+	req, err := am.NewRequest(ctx, http.MethodGet, endpoint, nil, tenant)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if am.authentication {
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", am.token))
+	}
+
+	resp, err := am.do(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer resp.Body.Close() //nolint: errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, "failed to list alerts")
+	}
+
+	var alerts []Alert
+	err = json.NewDecoder(resp.Body).Decode(&alerts)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return alerts, nil
+}
+
+func (am *Alertmanager) DeleteSilenceByID(ctx context.Context, id string, tenant string) error {
 	endpoint := fmt.Sprintf("%s%s/%s", am.address, apiV2SilencePath, url.PathEscape(id))
 
-	req, err := am.NewRequest(http.MethodDelete, endpoint, nil, tenant)
+	req, err := am.NewRequest(ctx, http.MethodDelete, endpoint, nil, tenant)
 	if err != nil {
 		return errors.WithStack(err)
 	}
@@ -187,26 +329,22 @@ func (am *Alertmanager) DeleteSilenceByID(id string, tenant string) error {
 		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", am.token))
 	}
 
-	resp, err := am.client.Do(req)
+	resp, err := am.do(req)
 	if err != nil {
 		return errors.WithStack(err)
 	}
 	defer resp.Body.Close() //nolint: errcheck
 
-	if resp.StatusCode != 200 {
-		return errors.WithMessagef(errors.WithStack(err), "failed to delete silence %#q, expected code 200, got %d", id, resp.StatusCode)
+	if resp.StatusCode == http.StatusNotFound {
+		return errors.WithMessagef(ErrSilenceNotFound, "failed to delete silence %#q", id)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return newAPIError(resp, "failed to delete silence %#q", id)
 	}
 
 	return nil
 }
 
-func SilenceComment(silence client.Object) string {
-	if silence.GetNamespace() != "" {
-		return fmt.Sprintf("%s-%s-%s", CreatedBy, silence.GetNamespace(), silence.GetName())
-	}
-	return fmt.Sprintf("%s-%s", CreatedBy, silence.GetName())
-}
-
 // SilenceEndsAt gets the expiry date for a given silence.
 // The expiry date is retrieved from the annotation name configured by ValidUntilAnnotationName.
 // The expected format is defined by DateOnlyLayout.
@@ -1,17 +1,26 @@
 package alertmanager
 
 import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	"github.com/giantswarm/silence-operator/api/v1alpha1"
 	"github.com/giantswarm/silence-operator/pkg/config"
+	"github.com/giantswarm/silence-operator/pkg/metrics"
 )
 
 // Values shared by the tests in this file.
@@ -77,17 +86,47 @@ func TestNew(t *testing.T) {
 	}
 }
 
-func TestSilenceComment(t *testing.T) {
-	silence := &v1alpha1.Silence{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: "test-silence",
+func TestFormatComment(t *testing.T) {
+	tests := []struct {
+		name     string
+		details  CommentDetails
+		expected string
+	}{
+		{
+			name:     "no details",
+			expected: "silence-operator-ns-test-silence",
+		},
+		{
+			name: "all details",
+			details: CommentDetails{
+				Description: "Cluster is being decommissioned.\n",
+				Owner:       "team-atlas",
+				Links: []CommentLink{
+					{Name: "Issue", URL: "https://github.com/giantswarm/giantswarm/issues/1"},
+					{URL: "https://example.com/runbook"},
+				},
+			},
+			expected: "silence-operator-ns-test-silence\n\n" +
+				"Cluster is being decommissioned.\n\n" +
+				"Owner: team-atlas\n" +
+				"Issue: https://github.com/giantswarm/giantswarm/issues/1\n" +
+				"https://example.com/runbook",
+		},
+		{
+			name:     "owner only",
+			details:  CommentDetails{Owner: "team-atlas"},
+			expected: "silence-operator-ns-test-silence\n\nOwner: team-atlas",
 		},
 	}
 
-	comment := SilenceComment(silence)
-	expected := "silence-operator-test-silence"
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comment := FormatComment("silence-operator-ns-test-silence", tt.details)
 
-	assert.Equal(t, expected, comment)
+			assert.Equal(t, tt.expected, comment)
+			assert.Equal(t, "silence-operator-ns-test-silence", CommentIdentity(comment))
+		})
+	}
 }
 
 func TestSilenceEndsAt(t *testing.T) {
@@ -214,7 +253,7 @@ func TestAlertmanager_ListSilences(t *testing.T) {
 	am, err := New(config)
 	require.NoError(t, err)
 
-	silences, err := am.ListSilences("")
+	silences, err := am.ListSilences(context.Background(), "")
 
 	assert.NoError(t, err)
 	assert.Len(t, silences, 1) // Only non-expired silences should be returned
@@ -222,6 +261,51 @@ func TestAlertmanager_ListSilences(t *testing.T) {
 	assert.Equal(t, "test-comment-1", silences[0].Comment)
 }
 
+func TestAlertmanager_ListAlerts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/alerts", r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "tenant-a", r.Header.Get("X-Scope-OrgID"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`[
+			{
+				"fingerprint": "abc",
+				"labels": {"alertname": "test-alert", "severity": "page"},
+				"startsAt": "2023-01-01T10:00:00Z",
+				"status": {"state": "suppressed", "silencedBy": ["test-id-1"], "inhibitedBy": []}
+			}
+		]`))
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	am, err := New(config.Config{Address: server.URL})
+	require.NoError(t, err)
+
+	alerts, err := am.ListAlerts(context.Background(), "tenant-a")
+
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+	assert.Equal(t, "abc", alerts[0].Fingerprint)
+	assert.Equal(t, map[string]string{"alertname": "test-alert", "severity": "page"}, alerts[0].Labels)
+	assert.Equal(t, []string{"test-id-1"}, alerts[0].Status.SilencedBy)
+}
+
+func TestAlertmanager_ListAlerts_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	am, err := New(config.Config{Address: server.URL})
+	require.NoError(t, err)
+
+	_, err = am.ListAlerts(context.Background(), "")
+	assert.ErrorContains(t, err, "expected code 200, got 500")
+}
+
 func TestAlertmanager_GetSilenceByComment(t *testing.T) {
 	// Create test server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -274,7 +358,7 @@ func TestAlertmanager_GetSilenceByComment(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			silence, err := am.GetSilenceByComment(tt.comment, "")
+			silence, err := am.GetSilenceByComment(context.Background(), tt.comment, "")
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -290,6 +374,125 @@ func TestAlertmanager_GetSilenceByComment(t *testing.T) {
 	}
 }
 
+func TestAlertmanager_GetSilenceByComment_WithDetails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`[
+			{
+				"id": "test-id-1",
+				"comment": "silence-operator-test-silence\n\nOld description",
+				"createdBy": "silence-operator",
+				"matchers": [],
+				"status": {
+					"state": "active"
+				}
+			}
+		]`))
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	am, err := New(config.Config{Address: server.URL})
+	require.NoError(t, err)
+
+	for _, comment := range []string{"silence-operator-test-silence", "silence-operator-test-silence\n\nNew description"} {
+		silence, err := am.GetSilenceByComment(context.Background(), comment, "")
+		require.NoError(t, err)
+		assert.Equal(t, "test-id-1", silence.ID)
+	}
+
+	_, err = am.GetSilenceByComment(context.Background(), "silence-operator-test", "")
+	assert.ErrorIs(t, err, ErrSilenceNotFound)
+}
+
+func TestAlertmanager_GetSilenceByComment_Instances(t *testing.T) {
+	identity := Identity{Group: "observability.giantswarm.io", Kind: "Silence", Namespace: "ns", Name: "shared", UID: "1234"}
+	workload := identity
+	workload.Instance = "workload"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode([]Silence{
+			{ID: "management-id", Comment: workload.String(), CreatedBy: Instance("management").CreatedBy(), Status: &Status{State: "active"}},
+			{ID: "workload-id", Comment: workload.String(), CreatedBy: Instance("workload").CreatedBy(), Status: &Status{State: "active"}},
+			{ID: "user-id", Comment: identity.String(), CreatedBy: "alice", Status: &Status{State: "active"}},
+		})
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	am, err := New(config.Config{Address: server.URL})
+	require.NoError(t, err)
+
+	silence, err := am.GetSilenceByComment(context.Background(), workload.String(), "")
+	require.NoError(t, err)
+	assert.Equal(t, "workload-id", silence.ID, "silences of other instances are skipped")
+
+	_, err = am.GetSilenceByComment(context.Background(), identity.String(), "")
+	assert.ErrorIs(t, err, ErrSilenceNotFound, "silences not created by the operator are skipped")
+}
+
+func TestAlertmanager_GetSilenceByID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v2/silence/test-id-1":
+			_, err := w.Write([]byte(`{"id": "test-id-1", "comment": "silence-operator-test-silence", "status": {"state": "active"}}`))
+			assert.NoError(t, err)
+		case "/api/v2/silence/test-id-2":
+			_, err := w.Write([]byte(`{"id": "test-id-2", "comment": "silence-operator-test-silence", "status": {"state": "expired"}}`))
+			assert.NoError(t, err)
+		case "/api/v2/silence/test-id-3":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	am, err := New(config.Config{Address: server.URL})
+	require.NoError(t, err)
+
+	silence, err := am.GetSilenceByID(context.Background(), "test-id-1", "")
+	require.NoError(t, err)
+	assert.Equal(t, "silence-operator-test-silence", silence.Comment)
+
+	_, err = am.GetSilenceByID(context.Background(), "test-id-2", "")
+	assert.ErrorIs(t, err, ErrSilenceNotFound)
+
+	_, err = am.GetSilenceByID(context.Background(), "test-id-3", "")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrSilenceNotFound)
+
+	_, err = am.GetSilenceByID(context.Background(), "unknown", "")
+	assert.ErrorIs(t, err, ErrSilenceNotFound)
+}
+
+func TestAlertmanager_ListSilences_WithFilter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, []string{`alertname="Foo"`, `instance=".*\\.prod\"\n"`}, r.URL.Query()["filter"])
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`[]`))
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	am, err := New(config.Config{Address: server.URL})
+	require.NoError(t, err)
+
+	_, err = am.ListSilences(context.Background(), "",
+		Matcher{Name: "alertname", Value: "Foo", IsEqual: true},
+		Matcher{Name: "instance", Value: ".*\\.prod\"\n", IsRegex: true, IsEqual: true},
+		Matcher{Name: "not.a.label", Value: "x", IsEqual: true},
+	)
+	assert.NoError(t, err)
+}
+
 func TestAlertmanager_CreateSilence(t *testing.T) {
 	// Create test server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -298,6 +501,8 @@ func TestAlertmanager_CreateSilence(t *testing.T) {
 		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
 
 		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"silenceID":"test-id"}`))
+		assert.NoError(t, err)
 	}))
 	defer server.Close()
 
@@ -322,8 +527,9 @@ func TestAlertmanager_CreateSilence(t *testing.T) {
 		},
 	}
 
-	err = am.CreateSilence(silence, "")
+	id, err := am.CreateSilence(context.Background(), silence, "")
 	assert.NoError(t, err)
+	assert.Equal(t, "test-id", id)
 }
 
 func TestAlertmanager_UpdateSilence(t *testing.T) {
@@ -334,6 +540,8 @@ func TestAlertmanager_UpdateSilence(t *testing.T) {
 		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
 
 		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"silenceID":"test-id"}`))
+		assert.NoError(t, err)
 	}))
 	defer server.Close()
 
@@ -359,8 +567,9 @@ func TestAlertmanager_UpdateSilence(t *testing.T) {
 		},
 	}
 
-	err = am.UpdateSilence(silence, "")
+	id, err := am.UpdateSilence(context.Background(), silence, "")
 	assert.NoError(t, err)
+	assert.Equal(t, "test-id", id)
 }
 
 func TestAlertmanager_UpdateSilence_MissingID(t *testing.T) {
@@ -377,7 +586,7 @@ func TestAlertmanager_UpdateSilence_MissingID(t *testing.T) {
 		EndsAt:    time.Now().Add(time.Hour),
 	}
 
-	err = am.UpdateSilence(silence, "")
+	_, err = am.UpdateSilence(context.Background(), silence, "")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "missing ID")
 }
@@ -399,10 +608,125 @@ func TestAlertmanager_DeleteSilenceByID(t *testing.T) {
 	am, err := New(config)
 	require.NoError(t, err)
 
-	err = am.DeleteSilenceByID("test-id", "")
+	err = am.DeleteSilenceByID(context.Background(), "test-id", "")
 	assert.NoError(t, err)
 }
 
+func TestAlertmanager_APIErrors(t *testing.T) {
+	tests := []struct {
+		name              string
+		status            int
+		body              string
+		call              func(am *Alertmanager) error
+		expectedMessage   string
+		expectedBody      string
+		expectedRetryable bool
+		expectedPermanent bool
+	}{
+		{
+			name:   "invalid silence",
+			status: http.StatusBadRequest,
+			body:   "silence invalid: invalid label matcher 0: invalid label name \"\"\n",
+			call: func(am *Alertmanager) error {
+				_, err := am.CreateSilence(context.Background(), &Silence{Comment: testComment}, "")
+				return err
+			},
+			expectedMessage:   "failed to create/update silence `test-comment`, expected code 200, got 400: silence invalid: invalid label matcher 0: invalid label name \"\"",
+			expectedBody:      "silence invalid: invalid label matcher 0: invalid label name \"\"",
+			expectedPermanent: true,
+		},
+		{
+			name:   "unauthorized list",
+			status: http.StatusUnauthorized,
+			body:   "<html>\n  <body>Unauthorized</body>\n</html>",
+			call: func(am *Alertmanager) error {
+				_, err := am.ListSilences(context.Background(), "")
+				return err
+			},
+			expectedMessage: "failed to list silences, expected code 200, got 401: <html> <body>Unauthorized</body> </html>",
+			expectedBody:    "<html> <body>Unauthorized</body> </html>",
+		},
+		{
+			name:   "failed delete",
+			status: http.StatusServiceUnavailable,
+			call: func(am *Alertmanager) error {
+				return am.DeleteSilenceByID(context.Background(), "test-id", "")
+			},
+			expectedMessage:   "failed to delete silence `test-id`, expected code 200, got 503",
+			expectedRetryable: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			am, err := New(config.Config{Address: server.URL})
+			require.NoError(t, err)
+
+			err = tt.call(am)
+			var apiErr *APIError
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, tt.status, apiErr.StatusCode)
+			assert.Equal(t, tt.expectedBody, apiErr.Body)
+			assert.EqualError(t, err, tt.expectedMessage)
+			assert.Equal(t, tt.expectedRetryable, apiErr.Retryable())
+			assert.Equal(t, tt.expectedPermanent, IsPermanent(err))
+		})
+	}
+
+	t.Run("missing silence", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		am, err := New(config.Config{Address: server.URL})
+		require.NoError(t, err)
+
+		err = am.DeleteSilenceByID(context.Background(), "test-id", "")
+		assert.ErrorIs(t, err, ErrSilenceNotFound)
+	})
+
+	t.Run("aggregated errors", func(t *testing.T) {
+		rejected := &APIError{StatusCode: http.StatusBadRequest}
+		failed := &APIError{StatusCode: http.StatusInternalServerError}
+		assert.True(t, IsPermanent(utilerrors.NewAggregate([]error{rejected, errors.Wrap(rejected, "target")})))
+		assert.False(t, IsPermanent(utilerrors.NewAggregate([]error{rejected, failed})))
+		assert.False(t, IsPermanent(errors.New("connection refused")))
+	})
+}
+
+func TestAlertmanager_RequestMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	am, err := New(config.Config{Address: server.URL})
+	require.NoError(t, err)
+
+	requests := metrics.AlertmanagerRequests.WithLabelValues(http.MethodGet, "500", "metrics-tenant")
+	before := testutil.ToFloat64(requests)
+
+	_, err = am.GetSilenceByID(context.Background(), "test-id", "metrics-tenant")
+	assert.Error(t, err)
+	assert.Equal(t, before+1, testutil.ToFloat64(requests))
+
+	// Requests failing without a response are recorded as errors.
+	server.Close()
+	failed := metrics.AlertmanagerRequests.WithLabelValues(http.MethodGet, "error", "metrics-tenant")
+	before = testutil.ToFloat64(failed)
+
+	_, err = am.GetSilenceByID(context.Background(), "test-id", "metrics-tenant")
+	assert.Error(t, err)
+	assert.Equal(t, before+1, testutil.ToFloat64(failed))
+}
+
 func TestAlertmanager_DeleteSilenceByComment(t *testing.T) {
 	callCount := 0
 	// Create test server
@@ -444,7 +768,7 @@ func TestAlertmanager_DeleteSilenceByComment(t *testing.T) {
 	am, err := New(config)
 	require.NoError(t, err)
 
-	err = am.DeleteSilenceByComment(testComment, "")
+	err = am.DeleteSilenceByComment(context.Background(), testComment, "")
 	assert.NoError(t, err)
 	assert.Equal(t, 2, callCount) // Should have made both calls
 }
@@ -471,10 +795,138 @@ func TestAlertmanager_WithAuthentication(t *testing.T) {
 	am, err := New(config)
 	require.NoError(t, err)
 
-	_, err = am.ListSilences("")
+	_, err = am.ListSilences(context.Background(), "")
 	assert.NoError(t, err)
 }
 
+func TestAlertmanager_WithHTTPClientConfig(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "silence-operator", username)
+		assert.Equal(t, "secret", password)
+		assert.Equal(t, "platform", r.Header.Get("X-Team"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`[]`))
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.crt")
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0o600))
+	passwordFile := filepath.Join(dir, "password")
+	require.NoError(t, os.WriteFile(passwordFile, []byte("secret"), 0o600))
+
+	httpClient, err := config.LoadHTTPClientConfig(config.HTTPClientFlags{
+		BasicAuthUsername:     "silence-operator",
+		BasicAuthPasswordFile: passwordFile,
+		CAFile:                caFile,
+		// The certificate of the test server is issued for example.com.
+		ServerName: "example.com",
+		Headers:    map[string]string{"X-Team": "platform"},
+	})
+	require.NoError(t, err)
+
+	am, err := New(config.Config{Address: server.URL, HTTPClient: httpClient})
+	require.NoError(t, err)
+
+	_, err = am.ListSilences(context.Background(), "")
+	assert.NoError(t, err)
+
+	t.Run("unknown CA", func(t *testing.T) {
+		am, err := New(config.Config{Address: server.URL})
+		require.NoError(t, err)
+
+		_, err = am.ListSilences(context.Background(), "")
+		assert.Error(t, err)
+	})
+}
+
+func TestAlertmanager_Retries(t *testing.T) {
+	tests := []struct {
+		name          string
+		method        string
+		failures      int
+		status        int
+		expectedCalls int
+		expectError   bool
+	}{
+		{name: "transient errors are retried", method: http.MethodGet, failures: 2, status: http.StatusServiceUnavailable, expectedCalls: 3},
+		{name: "retries are bounded", method: http.MethodGet, failures: 5, status: http.StatusBadGateway, expectedCalls: 3, expectError: true},
+		{name: "client errors are not retried", method: http.MethodGet, failures: 1, status: http.StatusBadRequest, expectedCalls: 1, expectError: true},
+		{name: "creating silences is not retried", method: http.MethodPost, failures: 1, status: http.StatusServiceUnavailable, expectedCalls: 1, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.method, r.Method)
+				calls++
+				if calls <= tt.failures {
+					w.WriteHeader(tt.status)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method == http.MethodPost {
+					_, _ = w.Write([]byte(`{"silenceID":"id"}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"id":"id","createdBy":"silence-operator"}`))
+			}))
+			defer server.Close()
+
+			am, err := New(config.Config{Address: server.URL, MaxRetries: 2})
+			require.NoError(t, err)
+
+			if tt.method == http.MethodPost {
+				_, err = am.CreateSilence(context.Background(), &Silence{Comment: testComment}, "")
+			} else {
+				_, err = am.GetSilenceByID(context.Background(), "id", "")
+			}
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedCalls, calls)
+		})
+	}
+}
+
+func TestAlertmanager_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	t.Run("request timeout", func(t *testing.T) {
+		am, err := New(config.Config{Address: server.URL, RequestTimeout: 50 * time.Millisecond})
+		require.NoError(t, err)
+
+		_, err = am.ListSilences(context.Background(), "")
+		assert.Error(t, err)
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		am, err := New(config.Config{Address: server.URL, MaxRetries: 2})
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err = am.ListSilences(ctx, "")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
 func TestAlertmanager_WithTenantID(t *testing.T) {
 	// Create test server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -496,7 +948,7 @@ func TestAlertmanager_WithTenantID(t *testing.T) {
 	am, err := New(config)
 	require.NoError(t, err)
 
-	_, err = am.ListSilences("")
+	_, err = am.ListSilences(context.Background(), "")
 	assert.NoError(t, err)
 }
 
@@ -511,6 +963,8 @@ func TestAlertmanager_CreateSilence_WithTenant(t *testing.T) {
 		assert.Equal(t, "test-tenant", r.Header.Get("X-Scope-OrgID"))
 
 		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"silenceID":"test-id"}`))
+		assert.NoError(t, err)
 	}))
 	defer server.Close()
 
@@ -535,8 +989,9 @@ func TestAlertmanager_CreateSilence_WithTenant(t *testing.T) {
 		},
 	}
 
-	err = am.CreateSilence(silence, "test-tenant")
+	id, err := am.CreateSilence(context.Background(), silence, "test-tenant")
 	assert.NoError(t, err)
+	assert.Equal(t, "test-id", id)
 }
 
 func TestAlertmanager_ListSilences_WithTenant(t *testing.T) {
@@ -571,7 +1026,7 @@ func TestAlertmanager_ListSilences_WithTenant(t *testing.T) {
 	am, err := New(config)
 	require.NoError(t, err)
 
-	silences, err := am.ListSilences("test-tenant")
+	silences, err := am.ListSilences(context.Background(), "test-tenant")
 	assert.NoError(t, err)
 	assert.Len(t, silences, 1)
 	assert.Equal(t, "test-id-1", silences[0].ID)
@@ -609,7 +1064,7 @@ func TestAlertmanager_GetSilenceByComment_WithTenant(t *testing.T) {
 	am, err := New(config)
 	require.NoError(t, err)
 
-	silence, err := am.GetSilenceByComment("silence-operator-test-silence", "test-tenant")
+	silence, err := am.GetSilenceByComment(context.Background(), "silence-operator-test-silence", "test-tenant")
 	assert.NoError(t, err)
 	assert.NotNil(t, silence)
 	assert.Equal(t, "test-id-1", silence.ID)
@@ -633,7 +1088,7 @@ func TestAlertmanager_DeleteSilenceByID_WithTenant(t *testing.T) {
 	am, err := New(config)
 	require.NoError(t, err)
 
-	err = am.DeleteSilenceByID("test-id", "test-tenant")
+	err = am.DeleteSilenceByID(context.Background(), "test-id", "test-tenant")
 	assert.NoError(t, err)
 }
 
@@ -655,7 +1110,7 @@ func TestAlertmanager_TenantPrecedence(t *testing.T) {
 	am, err := New(config)
 	require.NoError(t, err)
 
-	_, err = am.ListSilences("param-tenant")
+	_, err = am.ListSilences(context.Background(), "param-tenant")
 	assert.NoError(t, err)
 }
 
@@ -677,6 +1132,6 @@ func TestAlertmanager_BackwardCompatibilityUsesInstanceTenant(t *testing.T) {
 	require.NoError(t, err)
 
 	// Old method should still use instance tenant when empty string passed
-	_, err = am.ListSilences("")
+	_, err = am.ListSilences(context.Background(), "")
 	assert.NoError(t, err)
 }
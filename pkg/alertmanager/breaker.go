@@ -0,0 +1,147 @@
+package alertmanager
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/giantswarm/silence-operator/pkg/metrics"
+)
+
+// ErrCircuitOpen is returned for calls short-circuited by an open circuit breaker.
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+// BreakerState is the state of a CircuitBreaker. The values are reported by the
+// AlertmanagerCircuitBreakerState metric.
+type BreakerState int
+
+const (
+	// BreakerClosed lets all calls through.
+	BreakerClosed BreakerState = iota
+	// BreakerHalfOpen lets a single call through to probe whether Alertmanager recovered.
+	BreakerHalfOpen
+	// BreakerOpen short-circuits all calls until the cooldown has passed.
+	BreakerOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerHalfOpen:
+		return "half-open"
+	case BreakerOpen:
+		return "open"
+	}
+	return "unknown"
+}
+
+// callOutcome is how a call let through by a CircuitBreaker ended.
+type callOutcome int
+
+const (
+	callSucceeded callOutcome = iota
+	callFailed
+	// callAborted is used for calls canceled by their caller, which say nothing about
+	// Alertmanager.
+	callAborted
+)
+
+// CircuitBreaker opens after threshold consecutive failed calls to an Alertmanager and
+// short-circuits calls while open. Once cooldown has passed, a single call probes the
+// Alertmanager: the breaker closes if it succeeds and opens again if it fails.
+type CircuitBreaker struct {
+	// target is the name of the AlertmanagerTarget, empty for the default Alertmanager.
+	target string
+	// threshold is the number of consecutive failures opening the breaker. Zero disables it.
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	// probing is set while the half-open breaker waits for the outcome of its probe.
+	probing bool
+}
+
+func newCircuitBreaker(target string, threshold int, cooldown time.Duration) *CircuitBreaker {
+	metrics.AlertmanagerCircuitBreakerState.WithLabelValues(target).Set(float64(BreakerClosed))
+	return &CircuitBreaker{
+		target:    target,
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// State returns the state of the breaker. An open breaker whose cooldown has passed is
+// reported as half-open.
+func (b *CircuitBreaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == BreakerOpen && b.now().Sub(b.openedAt) >= b.cooldown {
+		return BreakerHalfOpen
+	}
+	return b.state
+}
+
+// allow returns ErrCircuitOpen if the call must not be made. Every allowed call must be
+// followed by done.
+func (b *CircuitBreaker) allow() error {
+	if b.threshold <= 0 {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == BreakerOpen && b.now().Sub(b.openedAt) >= b.cooldown {
+		b.setState(BreakerHalfOpen)
+	}
+	if b.state == BreakerOpen || (b.state == BreakerHalfOpen && b.probing) {
+		metrics.AlertmanagerShortCircuitedCalls.WithLabelValues(b.target).Inc()
+		return errors.WithStack(ErrCircuitOpen)
+	}
+	if b.state == BreakerHalfOpen {
+		b.probing = true
+	}
+	return nil
+}
+
+// done records the outcome of a call allowed by allow.
+func (b *CircuitBreaker) done(outcome callOutcome) {
+	if b.threshold <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	probe := b.state == BreakerHalfOpen && b.probing
+	if probe {
+		b.probing = false
+	}
+
+	switch outcome {
+	case callSucceeded:
+		b.failures = 0
+		if b.state != BreakerClosed {
+			b.setState(BreakerClosed)
+		}
+	case callFailed:
+		b.failures++
+		if probe || (b.state == BreakerClosed && b.failures >= b.threshold) {
+			b.openedAt = b.now()
+			b.setState(BreakerOpen)
+		}
+	}
+}
+
+func (b *CircuitBreaker) setState(state BreakerState) {
+	b.state = state
+	metrics.AlertmanagerCircuitBreakerState.WithLabelValues(b.target).Set(float64(state))
+}
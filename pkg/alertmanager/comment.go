@@ -0,0 +1,55 @@
+package alertmanager
+
+import (
+	"strings"
+)
+
+// CommentDetails are human-readable details rendered into a silence comment.
+type CommentDetails struct {
+	Description string
+	Owner       string
+	Links       []CommentLink
+}
+
+type CommentLink struct {
+	Name string
+	URL  string
+}
+
+// FormatComment renders identity on the first line of a silence comment, followed by
+// details for people browsing silences in Alertmanager. The identity is what the operator
+// uses to find the silence again, see CommentIdentity.
+func FormatComment(identity string, details CommentDetails) string {
+	var b strings.Builder
+	b.WriteString(identity)
+
+	if description := strings.TrimSpace(details.Description); description != "" {
+		b.WriteString("\n\n")
+		b.WriteString(description)
+	}
+
+	if details.Owner != "" || len(details.Links) > 0 {
+		b.WriteString("\n")
+	}
+	if details.Owner != "" {
+		b.WriteString("\nOwner: ")
+		b.WriteString(details.Owner)
+	}
+	for _, link := range details.Links {
+		b.WriteString("\n")
+		if link.Name != "" {
+			b.WriteString(link.Name)
+			b.WriteString(": ")
+		}
+		b.WriteString(link.URL)
+	}
+
+	return b.String()
+}
+
+// CommentIdentity returns the identity line of a comment built by FormatComment.
+// Comments without details are their own identity.
+func CommentIdentity(comment string) string {
+	identity, _, _ := strings.Cut(comment, "\n")
+	return identity
+}
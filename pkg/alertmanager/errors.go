@@ -0,0 +1,83 @@
+package alertmanager
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// maxErrorBodyLength limits the excerpt of the response body kept in an APIError.
+const maxErrorBodyLength = 512
+
+// APIError is returned when Alertmanager responds with an unexpected status code.
+type APIError struct {
+	// Message describes the failed operation, e.g. "failed to list silences".
+	Message    string
+	StatusCode int
+	// Body is an excerpt of the response body, which usually explains the error, e.g.
+	// why a silence is invalid.
+	Body string
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%s, expected code 200, got %d", e.Message, e.StatusCode)
+	if e.Body != "" {
+		msg += ": " + e.Body
+	}
+	return msg
+}
+
+// Retryable reports whether the request may succeed when sent again right away, as
+// Alertmanager was overloaded or failed internally.
+func (e *APIError) Retryable() bool {
+	return retryableStatus(e.StatusCode)
+}
+
+// Permanent reports whether Alertmanager rejected the request itself, e.g. an invalid
+// silence, so that it fails the same way until it changes. Authentication and
+// authorization failures are not permanent, as they are fixed in the configuration.
+func (e *APIError) Permanent() bool {
+	switch e.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusProxyAuthRequired:
+		return false
+	}
+	return e.StatusCode >= 400 && e.StatusCode < 500 && !e.Retryable()
+}
+
+// IsPermanent reports whether err is an APIError rejecting the request, see
+// APIError.Permanent. Errors aggregating several errors, such as the ones of
+// k8s.io/apimachinery/pkg/util/errors, are permanent if all of them are.
+func IsPermanent(err error) bool {
+	var agg interface{ Errors() []error }
+	if errors.As(err, &agg) && len(agg.Errors()) > 0 {
+		for _, err := range agg.Errors() {
+			if !IsPermanent(err) {
+				return false
+			}
+		}
+		return true
+	}
+
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.Permanent()
+}
+
+// retryableStatus reports whether requests answered with code may succeed when repeated.
+func retryableStatus(code int) bool {
+	return code == http.StatusRequestTimeout || code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
+
+// newAPIError returns an APIError for resp with an excerpt of its body.
+func newAPIError(resp *http.Response, format string, args ...any) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyLength))
+	excerpt := strings.Join(strings.Fields(strings.ToValidUTF8(string(body), "")), " ")
+
+	return errors.WithStack(&APIError{
+		Message:    fmt.Sprintf(format, args...),
+		StatusCode: resp.StatusCode,
+		Body:       excerpt,
+	})
+}
@@ -0,0 +1,166 @@
+package alertmanager
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+
+	"github.com/giantswarm/silence-operator/pkg/config"
+	"github.com/giantswarm/silence-operator/pkg/metrics"
+)
+
+// Guard keeps the operator from overloading Alertmanagers. All clients wrapped by the same
+// Guard share one token-bucket rate limiter, so a burst of reconciliations is spread out,
+// and each wrapped client has its own CircuitBreaker, so calls to a failing Alertmanager
+// fail fast instead of piling up.
+type Guard struct {
+	limiter   *rate.Limiter
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	breakers map[string]*CircuitBreaker
+}
+
+// NewGuard creates a Guard from the rate limit and circuit breaker settings of config.
+func NewGuard(config config.Config) *Guard {
+	limit := rate.Limit(config.RateLimit)
+	if config.RateLimit <= 0 {
+		limit = rate.Inf
+	}
+
+	return &Guard{
+		limiter:   rate.NewLimiter(limit, max(config.RateLimitBurst, 1)),
+		threshold: config.CircuitBreakerThreshold,
+		cooldown:  config.CircuitBreakerCooldown,
+		breakers:  map[string]*CircuitBreaker{},
+	}
+}
+
+// Wrap returns client guarded by the shared rate limiter and a new circuit breaker for the
+// AlertmanagerTarget called target, or the default Alertmanager if target is empty. The
+// new breaker replaces the one of a client previously wrapped for target.
+func (g *Guard) Wrap(target string, client Client) Client {
+	breaker := newCircuitBreaker(target, g.threshold, g.cooldown)
+
+	g.mu.Lock()
+	g.breakers[target] = breaker
+	g.mu.Unlock()
+
+	return &guardedClient{client: client, limiter: g.limiter, breaker: breaker}
+}
+
+// Remove drops the circuit breaker of the AlertmanagerTarget called target and its metrics,
+// once the target was deleted.
+func (g *Guard) Remove(target string) {
+	g.mu.Lock()
+	delete(g.breakers, target)
+	g.mu.Unlock()
+
+	metrics.AlertmanagerCircuitBreakerState.DeleteLabelValues(target)
+	metrics.AlertmanagerShortCircuitedCalls.DeleteLabelValues(target)
+}
+
+// guardedClient is a Client whose calls wait for the rate limiter and pass the circuit
+// breaker.
+type guardedClient struct {
+	client  Client
+	limiter *rate.Limiter
+	breaker *CircuitBreaker
+}
+
+// Ensure guardedClient implements Client
+var _ Client = (*guardedClient)(nil)
+
+// call runs fn once the rate limiter and the circuit breaker allow it, and records its
+// outcome in the breaker. Silences not found and requests rejected by Alertmanager count
+// as success, as Alertmanager responded.
+func (c *guardedClient) call(ctx context.Context, fn func() error) error {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return errors.Wrap(err, "rate limited")
+	}
+	if err := c.breaker.allow(); err != nil {
+		return err
+	}
+
+	err := fn()
+	var apiErr *APIError
+	switch {
+	case err == nil, errors.Is(err, ErrSilenceNotFound), errors.As(err, &apiErr) && !apiErr.Retryable():
+		c.breaker.done(callSucceeded)
+	case ctx.Err() != nil:
+		c.breaker.done(callAborted)
+	default:
+		c.breaker.done(callFailed)
+	}
+	return err
+}
+
+func (c *guardedClient) GetSilenceByID(ctx context.Context, id string, tenant string) (*Silence, error) {
+	var silence *Silence
+	err := c.call(ctx, func() (err error) {
+		silence, err = c.client.GetSilenceByID(ctx, id, tenant)
+		return err
+	})
+	return silence, err
+}
+
+func (c *guardedClient) GetSilenceByComment(ctx context.Context, comment string, tenant string, filter ...Matcher) (*Silence, error) {
+	var silence *Silence
+	err := c.call(ctx, func() (err error) {
+		silence, err = c.client.GetSilenceByComment(ctx, comment, tenant, filter...)
+		return err
+	})
+	return silence, err
+}
+
+func (c *guardedClient) CreateSilence(ctx context.Context, s *Silence, tenant string) (string, error) {
+	var id string
+	err := c.call(ctx, func() (err error) {
+		id, err = c.client.CreateSilence(ctx, s, tenant)
+		return err
+	})
+	return id, err
+}
+
+func (c *guardedClient) UpdateSilence(ctx context.Context, s *Silence, tenant string) (string, error) {
+	var id string
+	err := c.call(ctx, func() (err error) {
+		id, err = c.client.UpdateSilence(ctx, s, tenant)
+		return err
+	})
+	return id, err
+}
+
+func (c *guardedClient) DeleteSilenceByComment(ctx context.Context, comment string, tenant string) error {
+	return c.call(ctx, func() error {
+		return c.client.DeleteSilenceByComment(ctx, comment, tenant)
+	})
+}
+
+func (c *guardedClient) DeleteSilenceByID(ctx context.Context, id string, tenant string) error {
+	return c.call(ctx, func() error {
+		return c.client.DeleteSilenceByID(ctx, id, tenant)
+	})
+}
+
+func (c *guardedClient) ListSilences(ctx context.Context, tenant string, filter ...Matcher) ([]Silence, error) {
+	var silences []Silence
+	err := c.call(ctx, func() (err error) {
+		silences, err = c.client.ListSilences(ctx, tenant, filter...)
+		return err
+	})
+	return silences, err
+}
+
+func (c *guardedClient) ListAlerts(ctx context.Context, tenant string) ([]Alert, error) {
+	var alerts []Alert
+	err := c.call(ctx, func() (err error) {
+		alerts, err = c.client.ListAlerts(ctx, tenant)
+		return err
+	})
+	return alerts, err
+}
@@ -0,0 +1,133 @@
+package alertmanager
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/giantswarm/silence-operator/pkg/config"
+	"github.com/giantswarm/silence-operator/pkg/metrics"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Now()
+	breaker := newCircuitBreaker("breaker-test", 2, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	// Failures below the threshold and a success in between keep the breaker closed.
+	require.NoError(t, breaker.allow())
+	breaker.done(callFailed)
+	require.NoError(t, breaker.allow())
+	breaker.done(callSucceeded)
+	require.NoError(t, breaker.allow())
+	breaker.done(callFailed)
+	assert.Equal(t, BreakerClosed, breaker.State())
+
+	// Aborted calls do not count.
+	require.NoError(t, breaker.allow())
+	breaker.done(callAborted)
+	assert.Equal(t, BreakerClosed, breaker.State())
+
+	require.NoError(t, breaker.allow())
+	breaker.done(callFailed)
+	assert.Equal(t, BreakerOpen, breaker.State())
+	assert.ErrorIs(t, breaker.allow(), ErrCircuitOpen)
+	assert.Equal(t, float64(BreakerOpen), testutil.ToFloat64(metrics.AlertmanagerCircuitBreakerState.WithLabelValues("breaker-test")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.AlertmanagerShortCircuitedCalls.WithLabelValues("breaker-test")))
+
+	// After the cooldown a single probe is let through, and its failure opens the breaker again.
+	now = now.Add(time.Minute)
+	assert.Equal(t, BreakerHalfOpen, breaker.State())
+	require.NoError(t, breaker.allow())
+	assert.ErrorIs(t, breaker.allow(), ErrCircuitOpen)
+	breaker.done(callFailed)
+	assert.Equal(t, BreakerOpen, breaker.State())
+
+	// A successful probe closes the breaker.
+	now = now.Add(time.Minute)
+	require.NoError(t, breaker.allow())
+	breaker.done(callSucceeded)
+	assert.Equal(t, BreakerClosed, breaker.State())
+	assert.NoError(t, breaker.allow())
+	assert.Equal(t, float64(BreakerClosed), testutil.ToFloat64(metrics.AlertmanagerCircuitBreakerState.WithLabelValues("breaker-test")))
+}
+
+func TestGuard(t *testing.T) {
+	var calls, status atomic.Int32
+	status.Store(http.StatusServiceUnavailable)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.URL.Path == apiV2SilencePath+"/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if code := int(status.Load()); code != http.StatusOK {
+			http.Error(w, "unavailable", code)
+			return
+		}
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	am, err := New(config.Config{Address: server.URL})
+	require.NoError(t, err)
+
+	t.Run("circuit breaker", func(t *testing.T) {
+		calls.Store(0)
+		guard := NewGuard(config.Config{CircuitBreakerThreshold: 2, CircuitBreakerCooldown: time.Hour})
+		client := guard.Wrap("guard-test", am)
+
+		// Silences not found are no failures.
+		for range 3 {
+			_, err = client.GetSilenceByID(context.Background(), "missing", "")
+			assert.ErrorIs(t, err, ErrSilenceNotFound)
+		}
+		assert.Equal(t, BreakerClosed, guard.breakers["guard-test"].State())
+
+		for range 2 {
+			_, err = client.ListSilences(context.Background(), "")
+			assert.Error(t, err)
+			assert.NotErrorIs(t, err, ErrCircuitOpen)
+		}
+		_, err = client.ListSilences(context.Background(), "")
+		assert.ErrorIs(t, err, ErrCircuitOpen)
+		assert.Equal(t, int32(5), calls.Load())
+		assert.Equal(t, BreakerOpen, guard.breakers["guard-test"].State())
+
+		// Wrapping the target again starts with a closed breaker.
+		client = guard.Wrap("guard-test", am)
+		status.Store(http.StatusOK)
+		_, err = client.ListSilences(context.Background(), "")
+		assert.NoError(t, err)
+		assert.Equal(t, BreakerClosed, guard.breakers["guard-test"].State())
+
+		// Removing the target drops its breaker and metrics.
+		guard.Remove("guard-test")
+		assert.Empty(t, guard.breakers)
+		assert.False(t, metrics.AlertmanagerCircuitBreakerState.DeleteLabelValues("guard-test"), "the gauge of the removed target is dropped")
+	})
+
+	t.Run("rate limit", func(t *testing.T) {
+		calls.Store(0)
+		guard := NewGuard(config.Config{RateLimit: 0.1, RateLimitBurst: 1})
+		client := guard.Wrap("", am)
+		other := guard.Wrap("other", am)
+
+		_, err = client.ListSilences(context.Background(), "")
+		require.NoError(t, err)
+
+		// The limiter is shared by all wrapped clients.
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err = other.ListSilences(ctx, "")
+		assert.Error(t, err)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
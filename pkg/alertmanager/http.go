@@ -1,15 +1,32 @@
 package alertmanager
 
 import (
+	"context"
 	"io"
+	"math/rand/v2"
 	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/giantswarm/silence-operator/pkg/metrics"
+)
+
+// tenantHeader is the header Alertmanager tenants are selected with.
+const tenantHeader = "X-Scope-OrgID"
+
+// Backoff between retries of a request. The delay doubles with every attempt up to
+// maxRetryDelay and is jittered, so that clients do not retry in lockstep.
+const (
+	baseRetryDelay = 100 * time.Millisecond
+	maxRetryDelay  = 5 * time.Second
 )
 
-// NewRequest creates a new http.Request with the given method, url and body.
+// NewRequest creates a new http.Request with the given method, url and body, which is
+// canceled when ctx is done.
 // It adds the tenantId as X-Scope-OrgID header to the request if it is set.
 // The tenant parameter takes precedence over the instance tenantId.
-func (am *Alertmanager) NewRequest(method, url string, body io.Reader, tenant string) (*http.Request, error) {
-	req, err := http.NewRequest(method, url, body)
+func (am *Alertmanager) NewRequest(ctx context.Context, method, url string, body io.Reader, tenant string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return nil, err
 	}
@@ -22,8 +39,71 @@ func (am *Alertmanager) NewRequest(method, url string, body io.Reader, tenant st
 
 	// Add tenant header if tenant is specified
 	if effectiveTenant != "" {
-		req.Header.Add("X-Scope-OrgID", effectiveTenant)
+		req.Header.Add(tenantHeader, effectiveTenant)
 	}
 
 	return req, nil
 }
+
+// do sends the request. Idempotent requests that fail without a response, or with a
+// response indicating a transient server error, are retried up to maxRetries times.
+func (am *Alertmanager) do(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := am.send(req)
+		if attempt >= am.maxRetries || !retryable(req, resp, err) {
+			return resp, err
+		}
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close() //nolint: errcheck
+		}
+
+		timer := time.NewTimer(retryDelay(attempt))
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryable reports whether the request can be sent again after it failed with err or
+// resp. Requests canceled by their context are not retried.
+func retryable(req *http.Request, resp *http.Response, err error) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodDelete:
+	default:
+		return false
+	}
+	if err != nil {
+		return req.Context().Err() == nil
+	}
+	return retryableStatus(resp.StatusCode)
+}
+
+// retryDelay returns the jittered delay before the retry following the given attempt.
+func retryDelay(attempt int) time.Duration {
+	delay := maxRetryDelay
+	if attempt < 16 {
+		delay = min(baseRetryDelay<<attempt, maxRetryDelay)
+	}
+	return delay/2 + rand.N(delay/2)
+}
+
+// send sends the request once and records it in the Alertmanager request metrics.
+// Requests that fail without a response are recorded with the code "error".
+func (am *Alertmanager) send(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := am.client.Do(req)
+
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+	labels := []string{req.Method, code, req.Header.Get(tenantHeader)}
+	metrics.AlertmanagerRequests.WithLabelValues(labels...).Inc()
+	metrics.AlertmanagerRequestDuration.WithLabelValues(labels...).Observe(time.Since(start).Seconds())
+
+	return resp, err
+}
@@ -0,0 +1,140 @@
+package alertmanager
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/silence-operator/api/v1alpha1"
+	"github.com/giantswarm/silence-operator/api/v1alpha2"
+)
+
+// identityMarker starts the identity line of the comments of the silences created by the
+// operator. Its version changes whenever the format of the line does.
+const identityMarker = CreatedBy + "/v1"
+
+// Identity identifies the resource an Alertmanager silence was created for. Unlike its
+// name, the UID of a resource changes when it is deleted and created again, so a recreated
+// resource does not take over the silence of its predecessor.
+type Identity struct {
+	// Instance is the operator installation that created the silence.
+	Instance  Instance
+	Group     string
+	Kind      string
+	Namespace string
+	Name      string
+	UID       types.UID
+}
+
+// ObjectIdentity returns the identity of the silence resource obj.
+func ObjectIdentity(obj client.Object) Identity {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	switch obj.(type) {
+	case *v1alpha1.Silence:
+		gvk = v1alpha1.GroupVersion.WithKind("Silence")
+	case *v1alpha2.Silence:
+		gvk = v1alpha2.GroupVersion.WithKind("Silence")
+	}
+
+	return Identity{
+		Group:     gvk.Group,
+		Kind:      gvk.Kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		UID:       obj.GetUID(),
+	}
+}
+
+// String renders the identity as the first line of a silence comment, e.g.
+// `silence-operator/v1 group=observability.giantswarm.io kind=Silence namespace=ns name=foo uid=...`.
+// The instance is left out for the default installation and the namespace for
+// cluster-scoped resources. Kubernetes names and instances contain neither spaces nor `=`,
+// so different identities never render the same.
+func (id Identity) String() string {
+	var b strings.Builder
+	b.WriteString(identityMarker)
+	if id.Instance != "" {
+		fmt.Fprintf(&b, " instance=%s", id.Instance)
+	}
+	fmt.Fprintf(&b, " group=%s kind=%s", id.Group, id.Kind)
+	if id.Namespace != "" {
+		fmt.Fprintf(&b, " namespace=%s", id.Namespace)
+	}
+	fmt.Fprintf(&b, " name=%s uid=%s", id.Name, id.UID)
+	return b.String()
+}
+
+// Legacy returns the identity the operator used before String, `silence-operator-<namespace>-<name>`,
+// which is ambiguous, e.g. for namespace `a-b` and name `c`, and namespace `a` and name `b-c`.
+func (id Identity) Legacy() string {
+	if id.Namespace != "" {
+		return fmt.Sprintf("%s-%s-%s", CreatedBy, id.Namespace, id.Name)
+	}
+	return fmt.Sprintf("%s-%s", CreatedBy, id.Name)
+}
+
+// ParseIdentity parses the identity line of comment. It returns false when the line was
+// not rendered by Identity.String, e.g. for legacy identities.
+func ParseIdentity(comment string) (Identity, bool) {
+	fields := strings.Fields(CommentIdentity(comment))
+	if len(fields) == 0 || fields[0] != identityMarker {
+		return Identity{}, false
+	}
+
+	var id Identity
+	for _, field := range fields[1:] {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok {
+			return Identity{}, false
+		}
+		switch key {
+		case "instance":
+			id.Instance = Instance(value)
+		case "group":
+			id.Group = value
+		case "kind":
+			id.Kind = value
+		case "namespace":
+			id.Namespace = value
+		case "name":
+			id.Name = value
+		case "uid":
+			id.UID = types.UID(value)
+		}
+	}
+	if id.Kind == "" || id.Name == "" || id.UID == "" {
+		return Identity{}, false
+	}
+	return id, true
+}
+
+// SameIdentity returns true when the comments identify the same resource. A legacy
+// identity only matches itself, as it may belong to several resources, see IsLegacySilence.
+func SameIdentity(comment, other string) bool {
+	line, otherLine := CommentIdentity(comment), CommentIdentity(other)
+	if line == otherLine {
+		return true
+	}
+
+	id, ok := ParseIdentity(line)
+	otherID, otherOK := ParseIdentity(otherLine)
+	return ok && otherOK && id == otherID
+}
+
+// IsLegacySilence returns true when silence was created by the default installation before
+// identities included the UID, for the resource with the same namespace and name as the
+// identity of comment. As legacy identities are ambiguous, it may also have been created
+// for another resource, so callers must make sure that it was not.
+func IsLegacySilence(silence *Silence, comment string) bool {
+	id, ok := ParseIdentity(comment)
+	return ok && id.Instance == "" && silence.CreatedBy == CreatedBy &&
+		CommentIdentity(silence.Comment) == id.Legacy()
+}
+
+// SilenceComment returns the identity of the Alertmanager silence created for silence by
+// the default installation, see Instance.SilenceComment.
+func SilenceComment(silence client.Object) string {
+	return Instance("").SilenceComment(silence)
+}
@@ -0,0 +1,100 @@
+package alertmanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/silence-operator/api/v1alpha1"
+	"github.com/giantswarm/silence-operator/api/v1alpha2"
+)
+
+func TestSilenceComment(t *testing.T) {
+	v1Silence := &v1alpha1.Silence{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-silence", UID: "1234"},
+	}
+	assert.Equal(t, "silence-operator/v1 group=monitoring.giantswarm.io kind=Silence name=test-silence uid=1234", SilenceComment(v1Silence))
+	assert.Equal(t, "silence-operator-test-silence", ObjectIdentity(v1Silence).Legacy())
+
+	v2Silence := &v1alpha2.Silence{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-silence", Namespace: "ns", UID: "5678"},
+	}
+	assert.Equal(t, "silence-operator/v1 group=observability.giantswarm.io kind=Silence namespace=ns name=test-silence uid=5678", SilenceComment(v2Silence))
+	assert.Equal(t, "silence-operator-ns-test-silence", ObjectIdentity(v2Silence).Legacy())
+}
+
+func TestParseIdentity(t *testing.T) {
+	identity := Identity{Group: "observability.giantswarm.io", Kind: "Silence", Namespace: "ns", Name: "foo", UID: "1234"}
+
+	parsed, ok := ParseIdentity(FormatComment(identity.String(), CommentDetails{Owner: "team-atlas"}))
+	assert.True(t, ok)
+	assert.Equal(t, identity, parsed)
+
+	for _, comment := range []string{
+		"",
+		"silence-operator-ns-foo",
+		"silence-operator/v1 group=observability.giantswarm.io kind=Silence name=foo",
+		"silence-operator/v1 kind=Silence name=foo uid",
+		"silence-operator/v2 group=observability.giantswarm.io kind=Silence name=foo uid=1234",
+	} {
+		_, ok := ParseIdentity(comment)
+		assert.False(t, ok, comment)
+	}
+}
+
+func TestSameIdentity(t *testing.T) {
+	identity := Identity{Group: "observability.giantswarm.io", Kind: "Silence", Namespace: "a-b", Name: "c", UID: "1234"}
+	collision := Identity{Group: "observability.giantswarm.io", Kind: "Silence", Namespace: "a", Name: "b-c", UID: "5678"}
+	recreated := identity
+	recreated.UID = "9012"
+	v1Silence := Identity{Group: "monitoring.giantswarm.io", Kind: "Silence", Name: "a-b-c", UID: "3456"}
+
+	tests := []struct {
+		name     string
+		comment  string
+		other    string
+		expected bool
+	}{
+		{name: "same identity", comment: identity.String(), other: identity.String() + "\n\nDescription", expected: true},
+		{name: "same legacy identity", comment: "silence-operator-a-b-c", other: "silence-operator-a-b-c\n\nDescription", expected: true},
+		{name: "legacy identity of the resource", comment: identity.Legacy(), other: identity.String()},
+		{name: "resource of the legacy identity", comment: identity.String(), other: identity.Legacy()},
+		{name: "namespace and name collision", comment: identity.String(), other: collision.String()},
+		{name: "recreated resource", comment: identity.String(), other: recreated.String()},
+		{name: "v1alpha1 and v1alpha2 collision", comment: identity.String(), other: v1Silence.String()},
+		{name: "other legacy identity", comment: identity.String(), other: "silence-operator-a-b-d"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, SameIdentity(tt.comment, tt.other))
+		})
+	}
+}
+
+func TestIsLegacySilence(t *testing.T) {
+	identity := Identity{Group: "observability.giantswarm.io", Kind: "Silence", Namespace: "a-b", Name: "c", UID: "1234"}
+	instance := identity
+	instance.Instance = "workload"
+
+	tests := []struct {
+		name     string
+		silence  Silence
+		comment  string
+		expected bool
+	}{
+		{name: "legacy silence", silence: Silence{Comment: "silence-operator-a-b-c\n\nDescription", CreatedBy: CreatedBy}, comment: identity.String(), expected: true},
+		{name: "other legacy identity", silence: Silence{Comment: "silence-operator-a-b-d", CreatedBy: CreatedBy}, comment: identity.String()},
+		{name: "created by someone else", silence: Silence{Comment: "silence-operator-a-b-c", CreatedBy: "someone"}, comment: identity.String()},
+		{name: "named instance", silence: Silence{Comment: "silence-operator-a-b-c", CreatedBy: Instance("workload").CreatedBy()}, comment: instance.String()},
+		{name: "current identity", silence: Silence{Comment: identity.String(), CreatedBy: CreatedBy}, comment: identity.String()},
+		{name: "legacy comment", silence: Silence{Comment: "silence-operator-a-b-c", CreatedBy: CreatedBy}, comment: "silence-operator-a-b-c"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsLegacySilence(&tt.silence, tt.comment))
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package alertmanager
+
+import (
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Instance names an installation of the operator among the ones sharing Alertmanagers,
+// e.g. in a management and a workload cluster. Each installation only touches the silences
+// whose createdBy is its own. The empty Instance is the default installation, which
+// creates silences the way releases without instances did.
+type Instance string
+
+// CreatedBy returns the createdBy of the silences created by the instance, CreatedBy for
+// the default installation and `silence-operator/<instance>` otherwise.
+func (i Instance) CreatedBy() string {
+	if i == "" {
+		return CreatedBy
+	}
+	return CreatedBy + "/" + string(i)
+}
+
+// Owns returns true when silence was created by the instance.
+func (i Instance) Owns(silence *Silence) bool {
+	return silence.CreatedBy == i.CreatedBy()
+}
+
+// SilenceComment returns the identity of the Alertmanager silence created for silence by
+// the instance. It is the first line of the silence comment, see FormatComment.
+func (i Instance) SilenceComment(silence client.Object) string {
+	identity := ObjectIdentity(silence)
+	identity.Instance = i
+	return identity.String()
+}
+
+// CommentInstance returns the instance named by the identity of comment. Legacy identities
+// belong to the default installation.
+func CommentInstance(comment string) Instance {
+	id, _ := ParseIdentity(comment)
+	return id.Instance
+}
+
+// CreatedByOperator returns true when createdBy is the one of any installation of the
+// operator, see Instance.CreatedBy.
+func CreatedByOperator(createdBy string) bool {
+	return createdBy == CreatedBy || strings.HasPrefix(createdBy, CreatedBy+"/")
+}
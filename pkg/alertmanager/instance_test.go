@@ -0,0 +1,79 @@
+package alertmanager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/silence-operator/api/v1alpha2"
+	"github.com/giantswarm/silence-operator/pkg/config"
+)
+
+func TestInstance(t *testing.T) {
+	silence := &v1alpha2.Silence{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-silence", Namespace: "ns", UID: "5678"},
+	}
+
+	assert.Equal(t, "silence-operator", Instance("").CreatedBy())
+	assert.Equal(t, SilenceComment(silence), Instance("").SilenceComment(silence))
+
+	instance := Instance("workload")
+	assert.Equal(t, "silence-operator/workload", instance.CreatedBy())
+	comment := instance.SilenceComment(silence)
+	assert.Equal(t, "silence-operator/v1 instance=workload group=observability.giantswarm.io kind=Silence namespace=ns name=test-silence uid=5678", comment)
+	assert.Equal(t, instance, CommentInstance(comment))
+	assert.Equal(t, Instance(""), CommentInstance(SilenceComment(silence)))
+	assert.Equal(t, Instance(""), CommentInstance("silence-operator-ns-test-silence"))
+
+	assert.True(t, instance.Owns(&Silence{CreatedBy: "silence-operator/workload"}))
+	assert.False(t, instance.Owns(&Silence{CreatedBy: "silence-operator"}))
+	assert.False(t, Instance("").Owns(&Silence{CreatedBy: "silence-operator/workload"}))
+
+	assert.False(t, SameIdentity(comment, SilenceComment(silence)), "instances do not share silences")
+
+	for createdBy, expected := range map[string]bool{
+		"silence-operator":          true,
+		"silence-operator/workload": true,
+		"silence-operator-fork":     false,
+		"someone":                   false,
+	} {
+		assert.Equal(t, expected, CreatedByOperator(createdBy), createdBy)
+	}
+}
+
+func TestAlertmanager_DeleteSilenceByCommentOfInstance(t *testing.T) {
+	silence := &v1alpha2.Silence{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-silence", Namespace: "ns", UID: "5678"},
+	}
+	legacy := ObjectIdentity(silence).Legacy()
+
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			deleted = append(deleted, r.URL.Path)
+			return
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode([]Silence{
+			{ID: "management", Comment: Instance("management").SilenceComment(silence), CreatedBy: "silence-operator/management", Status: &Status{State: "active"}},
+			{ID: "legacy-of-workload", Comment: legacy, CreatedBy: "silence-operator/workload", Status: &Status{State: "active"}},
+			{ID: "workload", Comment: Instance("workload").SilenceComment(silence), CreatedBy: "silence-operator/workload", Status: &Status{State: "active"}},
+		}))
+	}))
+	defer server.Close()
+
+	am, err := New(config.Config{Address: server.URL})
+	require.NoError(t, err)
+
+	err = am.DeleteSilenceByComment(context.Background(), legacy, "")
+	assert.True(t, errors.Is(err, ErrSilenceNotFound), "legacy comments only match silences of the default installation")
+
+	require.NoError(t, am.DeleteSilenceByComment(context.Background(), Instance("workload").SilenceComment(silence), ""))
+	assert.Equal(t, []string{"/api/v2/silence/workload"}, deleted)
+}
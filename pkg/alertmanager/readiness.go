@@ -0,0 +1,199 @@
+package alertmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/giantswarm/silence-operator/pkg/metrics"
+)
+
+const apiReadyPath = "/-/ready"
+
+// maxReadinessTenants is the number of tenants the debug endpoint checks on demand per request.
+const maxReadinessTenants = 5
+
+// readinessFilter restricts the silences listed by readiness checks to none, so that checks
+// only cost Alertmanager the authentication of the request.
+var readinessFilter = Matcher{Name: "silence_operator_readiness_check", Value: "true", IsEqual: true}
+
+// Ready returns an error unless Alertmanager reports that it is ready to serve requests.
+func (am *Alertmanager) Ready(ctx context.Context) error {
+	req, err := am.NewRequest(ctx, http.MethodGet, am.address+apiReadyPath, nil, "")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if am.authentication {
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", am.token))
+	}
+
+	resp, err := am.do(req)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer resp.Body.Close() //nolint: errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return newAPIError(resp, "alertmanager is not ready")
+	}
+	return nil
+}
+
+// ReadinessStatus is the outcome of a readiness check.
+type ReadinessStatus struct {
+	CheckedAt time.Time `json:"checkedAt"`
+	// Error is the reason why Alertmanager is not ready. It is empty when it is ready.
+	Error string `json:"error,omitempty"`
+	// Tenants holds the outcome of listing silences per tenant.
+	Tenants []TenantReadiness `json:"tenants"`
+}
+
+// TenantReadiness is the outcome of listing the silences of a tenant.
+type TenantReadiness struct {
+	Tenant string `json:"tenant"`
+	// Error is the reason the silences could not be listed. It is empty on success.
+	Error string `json:"error,omitempty"`
+}
+
+// ReadinessChecker checks every interval that Alertmanager is ready and that the silences
+// of the default tenant can be listed, which catches wrong addresses and credentials. The
+// outcome is exported as metrics.AlertmanagerReady and served by ServeHTTP. It is no
+// readiness probe of the pod, which also serves the webhooks, so an unavailable Alertmanager
+// does not block changes to Silences. It runs on every replica.
+type ReadinessChecker struct {
+	client   *Alertmanager
+	tenant   string
+	interval time.Duration
+	// guard rate limits the tenants checked on demand when set.
+	guard *Guard
+
+	mu     sync.RWMutex
+	status *ReadinessStatus
+}
+
+// NewReadinessChecker creates a ReadinessChecker for the default tenant of client.
+func NewReadinessChecker(client *Alertmanager, tenant string, interval time.Duration) *ReadinessChecker {
+	return &ReadinessChecker{client: client, tenant: tenant, interval: interval}
+}
+
+// UseGuard makes the tenants checked on demand wait for the shared rate limiter of guard. It
+// must be called before the checker is used.
+func (c *ReadinessChecker) UseGuard(guard *Guard) {
+	c.guard = guard
+}
+
+// Start checks Alertmanager right away and then every interval until ctx is done.
+func (c *ReadinessChecker) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("alertmanager-readiness")
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		checkCtx, cancel := context.WithTimeout(ctx, c.interval)
+		status := c.check(checkCtx, c.tenant)
+		cancel()
+
+		c.mu.Lock()
+		previous := c.status
+		c.status = status
+		c.mu.Unlock()
+
+		if status.Error == "" {
+			metrics.AlertmanagerReady.Set(1)
+		} else {
+			metrics.AlertmanagerReady.Set(0)
+		}
+
+		if status.Error != "" && (previous == nil || previous.Error != status.Error) {
+			logger.Info("Alertmanager is not ready", "reason", status.Error)
+		} else if status.Error == "" && previous != nil && previous.Error != "" {
+			logger.Info("Alertmanager is ready again")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, so that every replica
+// reports its own readiness.
+func (c *ReadinessChecker) NeedLeaderElection() bool {
+	return false
+}
+
+// ServeHTTP reports the last check as JSON. Up to maxReadinessTenants tenants given as tenant
+// query parameters are checked on demand and reported in addition to the default tenant.
+func (c *ReadinessChecker) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	var tenants []string
+	for _, tenant := range req.URL.Query()["tenant"] {
+		if tenant != c.tenant && !slices.Contains(tenants, tenant) {
+			tenants = append(tenants, tenant)
+		}
+	}
+	if len(tenants) > maxReadinessTenants {
+		http.Error(w, fmt.Sprintf("at most %d tenants can be checked per request", maxReadinessTenants), http.StatusBadRequest)
+		return
+	}
+
+	c.mu.RLock()
+	status := ReadinessStatus{}
+	if c.status != nil {
+		status = *c.status
+		status.Tenants = append([]TenantReadiness(nil), c.status.Tenants...)
+	}
+	c.mu.RUnlock()
+
+	for _, tenant := range tenants {
+		readiness := TenantReadiness{Tenant: tenant}
+		if c.guard != nil {
+			if err := c.guard.limiter.Wait(req.Context()); err != nil {
+				readiness.Error = err.Error()
+				status.Tenants = append(status.Tenants, readiness)
+				continue
+			}
+		}
+		status.Tenants = append(status.Tenants, c.checkTenant(req.Context(), tenant))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// check checks that Alertmanager is ready and that the silences of tenant can be listed.
+func (c *ReadinessChecker) check(ctx context.Context, tenant string) *ReadinessStatus {
+	status := &ReadinessStatus{CheckedAt: time.Now()}
+
+	if err := c.client.Ready(ctx); err != nil {
+		status.Error = err.Error()
+		return status
+	}
+
+	tenantStatus := c.checkTenant(ctx, tenant)
+	status.Tenants = []TenantReadiness{tenantStatus}
+	if tenantStatus.Error != "" {
+		status.Error = fmt.Sprintf("failed to list silences of tenant %q: %s", tenant, tenantStatus.Error)
+	}
+	return status
+}
+
+func (c *ReadinessChecker) checkTenant(ctx context.Context, tenant string) TenantReadiness {
+	readiness := TenantReadiness{Tenant: tenant}
+	if _, err := c.client.ListSilences(ctx, tenant, readinessFilter); err != nil {
+		readiness.Error = err.Error()
+	}
+	return readiness
+}
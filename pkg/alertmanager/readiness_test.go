@@ -0,0 +1,120 @@
+package alertmanager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/giantswarm/silence-operator/pkg/config"
+	"github.com/giantswarm/silence-operator/pkg/metrics"
+)
+
+func TestReadinessChecker(t *testing.T) {
+	var ready atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case apiReadyPath:
+			if !ready.Load() {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+		case apiV2SilencesPath:
+			if r.Header.Get(tenantHeader) == "forbidden" {
+				http.Error(w, "<html>forbidden</html>", http.StatusForbidden)
+				return
+			}
+			assert.Equal(t, []string{`silence_operator_readiness_check="true"`}, r.URL.Query()["filter"])
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	am, err := New(config.Config{Address: server.URL})
+	require.NoError(t, err)
+	checker := NewReadinessChecker(am, "default", 10*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = checker.Start(ctx)
+	}()
+
+	lastError := func() (string, bool) {
+		checker.mu.RLock()
+		defer checker.mu.RUnlock()
+		if checker.status == nil {
+			return "", false
+		}
+		return checker.status.Error, true
+	}
+	assert.Eventually(t, func() bool {
+		_, checked := lastError()
+		return checked
+	}, time.Second, 5*time.Millisecond)
+	reason, _ := lastError()
+	assert.Contains(t, reason, "alertmanager is not ready")
+	assert.Equal(t, float64(0), testutil.ToFloat64(metrics.AlertmanagerReady))
+
+	ready.Store(true)
+	assert.Eventually(t, func() bool {
+		reason, _ := lastError()
+		return reason == "" && testutil.ToFloat64(metrics.AlertmanagerReady) == 1
+	}, time.Second, 5*time.Millisecond)
+
+	t.Run("debug endpoint", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		checker.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/alertmanager?tenant=other&tenant=forbidden", nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var status ReadinessStatus
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+		assert.Empty(t, status.Error)
+		require.Len(t, status.Tenants, 3)
+		assert.Equal(t, TenantReadiness{Tenant: "default"}, status.Tenants[0])
+		assert.Equal(t, TenantReadiness{Tenant: "other"}, status.Tenants[1])
+		assert.Equal(t, "forbidden", status.Tenants[2].Tenant)
+		assert.NotEmpty(t, status.Tenants[2].Error)
+	})
+
+	t.Run("too many tenants", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		checker.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/alertmanager?tenant=a&tenant=b&tenant=c&tenant=d&tenant=e&tenant=f", nil))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = httptest.NewRecorder()
+		checker.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/alertmanager?tenant=a&tenant=a&tenant=default&tenant=b&tenant=c&tenant=d&tenant=e", nil))
+		assert.Equal(t, http.StatusOK, rec.Code, "duplicates and the default tenant are not counted")
+	})
+
+	t.Run("rate limit", func(t *testing.T) {
+		limited := NewReadinessChecker(am, "default", time.Minute)
+		limited.UseGuard(NewGuard(config.Config{RateLimit: 0.001, RateLimitBurst: 1}))
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		rec := httptest.NewRecorder()
+		limited.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/alertmanager?tenant=a&tenant=b", nil).WithContext(ctx))
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var status ReadinessStatus
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+		require.Len(t, status.Tenants, 2)
+		assert.Equal(t, TenantReadiness{Tenant: "a"}, status.Tenants[0])
+		assert.NotEmpty(t, status.Tenants[1].Error, "the second tenant exceeds the rate limit")
+	})
+
+	t.Run("tenant failure", func(t *testing.T) {
+		status := checker.check(context.Background(), "forbidden")
+		assert.Contains(t, status.Error, `failed to list silences of tenant "forbidden"`)
+	})
+}
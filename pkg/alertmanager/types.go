@@ -13,6 +13,8 @@ type Silence struct {
 	Matchers  []Matcher `json:"matchers"`
 	StartsAt  time.Time `json:"startsAt"`
 	Status    *Status   `json:"status"`
+	// UpdatedAt is set by Alertmanager and ignored when silences are created or updated.
+	UpdatedAt time.Time `json:"updatedAt,omitzero"`
 }
 
 type Matcher struct {
@@ -25,3 +27,16 @@ type Matcher struct {
 type Status struct {
 	State string `json:"state"`
 }
+
+// Alert is an alert as returned by the Alertmanager alerts API.
+type Alert struct {
+	Fingerprint string            `json:"fingerprint"`
+	Labels      map[string]string `json:"labels"`
+	StartsAt    time.Time         `json:"startsAt"`
+	Status      *AlertStatus      `json:"status"`
+}
+
+type AlertStatus struct {
+	State      string   `json:"state"`
+	SilencedBy []string `json:"silencedBy"`
+}
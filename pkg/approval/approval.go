@@ -0,0 +1,113 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package approval decides which silences are risky enough to require the approval
+// of a second identity before they are synced to Alertmanager.
+package approval
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+	"github.com/giantswarm/silence-operator/pkg/config"
+	"github.com/giantswarm/silence-operator/pkg/matcher"
+)
+
+const (
+	// CreatedByAnnotation records the user that created the silence. It is set by the
+	// mutating admission webhook and cannot be changed afterwards.
+	CreatedByAnnotation = "observability.giantswarm.io/created-by"
+	// ApprovedByAnnotation records the user that approved the silence. The mutating
+	// admission webhook replaces any value with the name of the requesting user and
+	// removes the annotation whenever the spec changes.
+	ApprovedByAnnotation = "observability.giantswarm.io/approved-by"
+)
+
+// wildcardProbes are label values no meaningful regex matcher accepts all of. A regex
+// matcher accepting every probe is treated as a wildcard, e.g. ".*" or ".+".
+var wildcardProbes = []string{"0", "wildcard-Probe_9.x"}
+
+// Policy holds the criteria that make a silence require approval.
+type Policy struct {
+	maxDuration    time.Duration
+	minMatchers    int
+	regexWildcards bool
+}
+
+// NewPolicy returns the approval policy configured in cfg, or nil when approvals are disabled.
+func NewPolicy(cfg config.Config) *Policy {
+	if !cfg.ApprovalEnabled {
+		return nil
+	}
+	return &Policy{
+		maxDuration:    cfg.ApprovalMaxDuration,
+		minMatchers:    cfg.ApprovalMinMatchers,
+		regexWildcards: cfg.ApprovalRegexWildcards,
+	}
+}
+
+// Risks returns why the silence requires approval. It returns nil when the silence can
+// be synced without approval. A nil Policy never requires approval.
+func (p *Policy) Risks(silence *alertmanager.Silence) []string {
+	if p == nil {
+		return nil
+	}
+
+	var risks []string
+
+	if duration := silence.EndsAt.Sub(silence.StartsAt); p.maxDuration > 0 && duration > p.maxDuration {
+		risks = append(risks, fmt.Sprintf("lasts %s, longer than %s", duration, p.maxDuration))
+	}
+
+	if p.minMatchers > 0 && len(silence.Matchers) < p.minMatchers {
+		risks = append(risks, fmt.Sprintf("has %d matchers, fewer than %d", len(silence.Matchers), p.minMatchers))
+	}
+
+	if p.regexWildcards {
+		for _, m := range silence.Matchers {
+			if isWildcard(m) {
+				risks = append(risks, fmt.Sprintf("matcher %s matches any value", matcher.String(m)))
+			}
+		}
+	}
+
+	return risks
+}
+
+// isWildcard reports whether m is a regex matcher that accepts any label value.
+func isWildcard(m alertmanager.Matcher) bool {
+	if !m.IsRegex || !m.IsEqual {
+		return false
+	}
+	for _, probe := range wildcardProbes {
+		if !matcher.MatchesValue(m, probe) {
+			return false
+		}
+	}
+	return true
+}
+
+// Approved reports whether obj has been approved by someone other than its creator. Objects
+// whose creator was not recorded, e.g. because they were created before the webhook, cannot
+// be approved, as their creator could otherwise approve them.
+func Approved(obj metav1.Object) bool {
+	annotations := obj.GetAnnotations()
+	createdBy, approvedBy := annotations[CreatedByAnnotation], annotations[ApprovedByAnnotation]
+	return createdBy != "" && approvedBy != "" && approvedBy != createdBy
+}
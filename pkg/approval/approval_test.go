@@ -0,0 +1,129 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+	"github.com/giantswarm/silence-operator/pkg/config"
+)
+
+func newSilence(duration time.Duration, matchers ...alertmanager.Matcher) *alertmanager.Silence {
+	startsAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &alertmanager.Silence{
+		StartsAt: startsAt,
+		EndsAt:   startsAt.Add(duration),
+		Matchers: matchers,
+	}
+}
+
+func TestNewPolicy(t *testing.T) {
+	assert.Nil(t, NewPolicy(config.Config{}))
+	assert.NotNil(t, NewPolicy(config.Config{ApprovalEnabled: true}))
+}
+
+func TestRisks(t *testing.T) {
+	policy := NewPolicy(config.Config{
+		ApprovalEnabled:        true,
+		ApprovalMaxDuration:    24 * time.Hour,
+		ApprovalMinMatchers:    2,
+		ApprovalRegexWildcards: true,
+	})
+
+	alertname := alertmanager.Matcher{Name: "alertname", Value: "Foo", IsEqual: true}
+	cluster := alertmanager.Matcher{Name: "cluster", Value: "prod", IsEqual: true}
+
+	tests := []struct {
+		name      string
+		policy    *Policy
+		silence   *alertmanager.Silence
+		wantRisks []string
+	}{
+		{
+			name:    "narrow and short silence",
+			policy:  policy,
+			silence: newSilence(time.Hour, alertname, cluster),
+		},
+		{
+			name:      "too long",
+			policy:    policy,
+			silence:   newSilence(100*365*24*time.Hour, alertname, cluster),
+			wantRisks: []string{"longer than 24h0m0s"},
+		},
+		{
+			name:      "too few matchers",
+			policy:    policy,
+			silence:   newSilence(time.Hour, alertname),
+			wantRisks: []string{"has 1 matchers, fewer than 2"},
+		},
+		{
+			name:      "regex wildcard",
+			policy:    policy,
+			silence:   newSilence(time.Hour, alertname, alertmanager.Matcher{Name: "cluster", Value: ".+", IsRegex: true, IsEqual: true}),
+			wantRisks: []string{`matcher cluster=~".+" matches any value`},
+		},
+		{
+			name:    "specific regex",
+			policy:  policy,
+			silence: newSilence(time.Hour, alertname, alertmanager.Matcher{Name: "cluster", Value: "prod-.*", IsRegex: true, IsEqual: true}),
+		},
+		{
+			name:    "negated wildcard",
+			policy:  policy,
+			silence: newSilence(time.Hour, alertname, alertmanager.Matcher{Name: "cluster", Value: ".*", IsRegex: true}),
+		},
+		{
+			name:    "disabled policy",
+			silence: newSilence(100*365*24*time.Hour, alertmanager.Matcher{Name: "alertname", Value: ".*", IsRegex: true, IsEqual: true}),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			risks := tc.policy.Risks(tc.silence)
+			assert.Len(t, risks, len(tc.wantRisks))
+			for i, want := range tc.wantRisks {
+				assert.Contains(t, risks[i], want)
+			}
+		})
+	}
+}
+
+func TestApproved(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+	}{
+		{name: "not approved"},
+		{name: "approved by someone else", annotations: map[string]string{CreatedByAnnotation: "alice", ApprovedByAnnotation: "bob"}, want: true},
+		{name: "approved by the creator", annotations: map[string]string{CreatedByAnnotation: "alice", ApprovedByAnnotation: "alice"}},
+		{name: "approved without known creator", annotations: map[string]string{ApprovedByAnnotation: "bob"}},
+		{name: "approved with empty creator", annotations: map[string]string{CreatedByAnnotation: "", ApprovedByAnnotation: "bob"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Approved(&metav1.ObjectMeta{Annotations: tc.annotations}))
+		})
+	}
+}
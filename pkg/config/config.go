@@ -1,17 +1,87 @@
 package config
 
 import (
+	"regexp"
+	"strings"
+	"time"
+
 	"github.com/pkg/errors"
+	promconfig "github.com/prometheus/common/config"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // Config struct holds all the configuration for the operator.
 type Config struct {
+	// Instance names this installation of the operator among the ones sharing
+	// Alertmanagers. It is stamped into the silences it creates, so that it only ever
+	// touches its own. Empty is the default installation.
+	Instance string
+
 	Address        string
 	Authentication bool
 	BearerToken    string
 	TenantId       string
+	// HTTPClient configures the HTTP client used to reach Alertmanager. If nil, the default
+	// HTTP client is used.
+	HTTPClient *promconfig.HTTPClientConfig
+	// RequestTimeout bounds each request to Alertmanager, including reading the response.
+	// Zero disables the timeout.
+	RequestTimeout time.Duration
+	// MaxRetries is how often requests to Alertmanager that are safe to repeat are retried
+	// after transient failures. Zero disables retries.
+	MaxRetries int
+
+	// SecretNamespace is the namespace of the operator, which the bearer token Secrets of
+	// AlertmanagerTargets are read from.
+	SecretNamespace string
+
+	// RateLimit is the number of calls per second shared by all Alertmanager clients. Zero
+	// disables the rate limit.
+	RateLimit float64
+	// RateLimitBurst is the number of calls that may exceed RateLimit at once.
+	RateLimitBurst int
+	// CircuitBreakerThreshold is the number of consecutive failed calls after which calls
+	// to an Alertmanager are short-circuited. Zero disables the circuit breaker.
+	CircuitBreakerThreshold int
+	// CircuitBreakerCooldown is how long calls are short-circuited before a call probes
+	// whether the Alertmanager recovered.
+	CircuitBreakerCooldown time.Duration
+	// ReadinessCheckInterval is how often the operator checks that the default
+	// Alertmanager is ready and that its silences can be listed. Zero disables the check.
+	ReadinessCheckInterval time.Duration
+
+	// SilenceCacheFreshness is how long silences listed from an Alertmanager tenant are
+	// shared between reconciliations. Zero disables the cache.
+	SilenceCacheFreshness time.Duration
+	// DriftDetectionInterval is how often the silences in Alertmanager are compared with
+	// the v1alpha2 silences they were synced from. Zero disables drift detection.
+	DriftDetectionInterval time.Duration
+
+	// OrphanSweepInterval is how often Alertmanager silences created by the operator whose
+	// Silence no longer exists are expired. Zero disables the sweep.
+	OrphanSweepInterval time.Duration
+	// OrphanGracePeriod is how long after their last update orphaned silences are kept.
+	OrphanGracePeriod time.Duration
+	// OrphanSweepDryRun only logs and counts orphaned silences instead of expiring them.
+	OrphanSweepDryRun bool
+
+	// AdoptionInterval is how often silences created outside of the operator in the default
+	// Alertmanager are adopted into v1alpha2 silences. Zero disables the adoption.
+	AdoptionInterval time.Duration
+	// AdoptionCreatedBy selects the silences to adopt by their creator. If nil, the creator
+	// is not checked.
+	AdoptionCreatedBy *regexp.Regexp
+	// AdoptionComment selects the silences to adopt by their comment. If nil, the comment
+	// is not checked.
+	AdoptionComment *regexp.Regexp
+	// AdoptionNamespaceLabel is the matcher label whose value is the namespace of the
+	// adopted silence.
+	AdoptionNamespaceLabel string
+	// AdoptionDefaultNamespace is the namespace of adopted silences without a matcher on
+	// AdoptionNamespaceLabel. If empty, those silences are not adopted.
+	AdoptionDefaultNamespace string
 
 	// SilenceSelector is used to filter silences based on label selectors.
 	// If nil, the controller will watch all silences.
@@ -20,6 +90,35 @@ type Config struct {
 	// If nil, the controller will watch all namespaces.
 	NamespaceSelector labels.Selector
 
+	// MigrationSelector selects v1alpha1 silences to migrate to v1alpha2 in addition to
+	// the ones carrying the migration annotation. If nil, only annotated silences are migrated.
+	MigrationSelector labels.Selector
+	// MigrationTargetNamespace is the namespace v1alpha1 silences are migrated into
+	// unless the migration annotation names another one.
+	MigrationTargetNamespace string
+
+	// TTLAfterExpiry deletes v1alpha2 silences once they have ended this long ago, unless
+	// they set spec.ttlAfterExpiry themselves. Zero keeps ended silences.
+	TTLAfterExpiry time.Duration
+
+	// MatchedAlertsWarningThreshold is the number of currently firing alerts a new silence
+	// may match before the admission webhook warns about it. Zero disables the warning.
+	MatchedAlertsWarningThreshold int
+	// MatchedAlertsRefreshInterval is how old the preview of the alerts matched by a v1alpha2
+	// silence may get before it is refreshed when the silence is reconciled. The preview is
+	// always refreshed when the silence changes. Zero only refreshes it then.
+	MatchedAlertsRefreshInterval time.Duration
+
+	// ApprovalEnabled holds v1alpha2 silences matching any of the approval criteria back
+	// until someone other than their creator approves them.
+	ApprovalEnabled bool
+	// ApprovalMaxDuration is the longest silence that does not require approval. Zero disables the check.
+	ApprovalMaxDuration time.Duration
+	// ApprovalMinMatchers is the fewest matchers a silence needs to not require approval. Zero disables the check.
+	ApprovalMinMatchers int
+	// ApprovalRegexWildcards requires approval for silences with regex matchers that match any value.
+	ApprovalRegexWildcards bool
+
 	// Tenancy configuration
 	TenancyEnabled       bool
 	TenancyLabelKey      string // Single label key to extract tenant from (e.g., "observability.giantswarm.io/tenant")
@@ -65,3 +164,39 @@ func ParseNamespaceSelector(namespaceSelector string) (labels.Selector, error) {
 	}
 	return selector, nil
 }
+
+// ParseMigrationSelector parses a migration selector string into a labels.Selector.
+// Returns nil if the selector is empty, which means only annotated silences are migrated.
+func ParseMigrationSelector(migrationSelector string) (labels.Selector, error) {
+	selector, err := parseSelector(migrationSelector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse migration-selector string: %q", migrationSelector)
+	}
+	return selector, nil
+}
+
+// ParseAdoptionPattern parses a regular expression selecting silences to adopt by creator
+// or comment. Returns nil if the pattern is empty, which means no filtering will be applied.
+func ParseAdoptionPattern(name, pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse %s pattern: %q", name, pattern)
+	}
+	return re, nil
+}
+
+// ParseInstance validates the name of an operator instance, which must be empty or a
+// DNS-1123 label, so that it can be embedded in silence comments.
+func ParseInstance(instance string) (string, error) {
+	if instance == "" {
+		return "", nil
+	}
+	if errs := validation.IsDNS1123Label(instance); len(errs) > 0 {
+		return "", errors.Errorf("unable to parse instance %q: %s", instance, strings.Join(errs, "; "))
+	}
+	return instance, nil
+}
@@ -139,3 +139,75 @@ func TestParseNamespaceSelector(t *testing.T) {
 		g.Expect(selector.Matches(nonMatchingLabels)).To(gomega.BeFalse())
 	})
 }
+
+func TestParseMigrationSelector(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	t.Run("empty selector returns nil", func(t *testing.T) {
+		selector, err := ParseMigrationSelector("")
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		g.Expect(selector).To(gomega.BeNil())
+	})
+
+	t.Run("valid selector", func(t *testing.T) {
+		selector, err := ParseMigrationSelector("environment=production")
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		g.Expect(selector).ToNot(gomega.BeNil())
+		g.Expect(selector.Matches(labels.Set{testLabelEnvironment: "production"})).To(gomega.BeTrue())
+	})
+
+	t.Run("invalid selector returns error", func(t *testing.T) {
+		selector, err := ParseMigrationSelector("invalid=label=selector")
+		g.Expect(err).To(gomega.HaveOccurred())
+		g.Expect(selector).To(gomega.BeNil())
+		g.Expect(err.Error()).To(gomega.ContainSubstring("unable to parse migration-selector string"))
+	})
+}
+
+func TestParseAdoptionPattern(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	t.Run("empty pattern returns nil", func(t *testing.T) {
+		re, err := ParseAdoptionPattern("adoption-created-by", "")
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		g.Expect(re).To(gomega.BeNil())
+	})
+
+	t.Run("valid pattern", func(t *testing.T) {
+		re, err := ParseAdoptionPattern("adoption-created-by", "^(alice|bob)$")
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		g.Expect(re.MatchString("alice")).To(gomega.BeTrue())
+		g.Expect(re.MatchString("mallory")).To(gomega.BeFalse())
+	})
+
+	t.Run("invalid pattern returns error", func(t *testing.T) {
+		re, err := ParseAdoptionPattern("adoption-comment", "(unclosed")
+		g.Expect(err).To(gomega.HaveOccurred())
+		g.Expect(re).To(gomega.BeNil())
+		g.Expect(err.Error()).To(gomega.ContainSubstring("unable to parse adoption-comment pattern"))
+	})
+}
+
+func TestParseInstance(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	t.Run("empty instance is the default installation", func(t *testing.T) {
+		instance, err := ParseInstance("")
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		g.Expect(instance).To(gomega.BeEmpty())
+	})
+
+	t.Run("valid instance", func(t *testing.T) {
+		instance, err := ParseInstance("management-cluster")
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		g.Expect(instance).To(gomega.Equal("management-cluster"))
+	})
+
+	t.Run("invalid instance returns error", func(t *testing.T) {
+		for _, invalid := range []string{"Management", "a b", "a=b", "a/b"} {
+			_, err := ParseInstance(invalid)
+			g.Expect(err).To(gomega.HaveOccurred(), invalid)
+			g.Expect(err.Error()).To(gomega.ContainSubstring("unable to parse instance"))
+		}
+	})
+}
@@ -0,0 +1,120 @@
+package config
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	promconfig "github.com/prometheus/common/config"
+)
+
+// HTTPClientFlags holds the flags configuring the HTTP client of the default Alertmanager.
+// Set flags override the corresponding settings of ConfigFile.
+type HTTPClientFlags struct {
+	// ConfigFile is a YAML file in the format of the Prometheus http_config. Relative
+	// paths in it are resolved against the directory of the file.
+	ConfigFile string
+
+	BasicAuthUsername     string
+	BasicAuthPasswordFile string
+	BearerTokenFile       string
+
+	CAFile             string
+	CertFile           string
+	KeyFile            string
+	ServerName         string
+	InsecureSkipVerify bool
+
+	ProxyURL string
+	// Headers are sent with every request, by header name.
+	Headers map[string]string
+}
+
+// Set reports whether any flag is set.
+func (f HTTPClientFlags) Set() bool {
+	return f.ConfigFile != "" || f.BasicAuthUsername != "" || f.BasicAuthPasswordFile != "" || f.BearerTokenFile != "" ||
+		f.CAFile != "" || f.CertFile != "" || f.KeyFile != "" || f.ServerName != "" || f.InsecureSkipVerify ||
+		f.ProxyURL != "" || len(f.Headers) > 0
+}
+
+// LoadHTTPClientConfig builds the HTTP client configuration from the flags. Returns nil if
+// no flag is set, which means the default HTTP client is used.
+func LoadHTTPClientConfig(f HTTPClientFlags) (*promconfig.HTTPClientConfig, error) {
+	if !f.Set() {
+		return nil, nil
+	}
+
+	cfg := promconfig.DefaultHTTPClientConfig
+	if f.ConfigFile != "" {
+		loaded, _, err := promconfig.LoadHTTPConfigFile(f.ConfigFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to load HTTP client config file %q", f.ConfigFile)
+		}
+		cfg = *loaded
+	}
+
+	if f.BasicAuthUsername != "" || f.BasicAuthPasswordFile != "" {
+		cfg.BasicAuth = &promconfig.BasicAuth{Username: f.BasicAuthUsername, PasswordFile: f.BasicAuthPasswordFile}
+	}
+	if f.BearerTokenFile != "" {
+		cfg.Authorization = &promconfig.Authorization{Type: "Bearer", CredentialsFile: f.BearerTokenFile}
+	}
+
+	if f.CAFile != "" {
+		cfg.TLSConfig.CA, cfg.TLSConfig.CAFile = "", f.CAFile
+	}
+	if f.CertFile != "" {
+		cfg.TLSConfig.Cert, cfg.TLSConfig.CertFile = "", f.CertFile
+	}
+	if f.KeyFile != "" {
+		cfg.TLSConfig.Key, cfg.TLSConfig.KeyFile = "", f.KeyFile
+	}
+	if f.ServerName != "" {
+		cfg.TLSConfig.ServerName = f.ServerName
+	}
+	if f.InsecureSkipVerify {
+		cfg.TLSConfig.InsecureSkipVerify = true
+	}
+
+	if f.ProxyURL != "" {
+		proxyURL, err := url.Parse(f.ProxyURL)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to parse proxy URL %q", f.ProxyURL)
+		}
+		cfg.ProxyURL = promconfig.URL{URL: proxyURL}
+	}
+
+	if len(f.Headers) > 0 {
+		if cfg.HTTPHeaders == nil {
+			cfg.HTTPHeaders = &promconfig.Headers{}
+		}
+		if cfg.HTTPHeaders.Headers == nil {
+			cfg.HTTPHeaders.Headers = map[string]promconfig.Header{}
+		}
+		for name, value := range f.Headers {
+			cfg.HTTPHeaders.Headers[name] = promconfig.Header{Values: []string{value}}
+		}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid HTTP client config")
+	}
+	return &cfg, nil
+}
+
+// ParseHeader parses a header given as "Name: value" into headers.
+func ParseHeader(headers map[string]string, header string) error {
+	name, value, ok := strings.Cut(header, ":")
+	name = strings.TrimSpace(name)
+	if !ok || name == "" {
+		return errors.Errorf("unable to parse header %q, expected \"Name: value\"", header)
+	}
+	headers[name] = strings.TrimSpace(value)
+	return nil
+}
+
+// HasAuthentication reports whether the HTTP client configuration authenticates requests
+// itself.
+func HasAuthentication(cfg *promconfig.HTTPClientConfig) bool {
+	return cfg != nil && (cfg.BasicAuth != nil || cfg.Authorization != nil || cfg.OAuth2 != nil || cfg.BearerToken != "" || cfg.BearerTokenFile != "")
+}
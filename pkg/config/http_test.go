@@ -0,0 +1,114 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestLoadHTTPClientConfig(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	t.Run("no flags returns nil", func(t *testing.T) {
+		cfg, err := LoadHTTPClientConfig(HTTPClientFlags{Headers: map[string]string{}})
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		g.Expect(cfg).To(gomega.BeNil())
+	})
+
+	t.Run("flags", func(t *testing.T) {
+		cfg, err := LoadHTTPClientConfig(HTTPClientFlags{
+			BasicAuthUsername:     "silence-operator",
+			BasicAuthPasswordFile: "/etc/alertmanager/password",
+			CAFile:                "/etc/alertmanager/ca.crt",
+			CertFile:              "/etc/alertmanager/tls.crt",
+			KeyFile:               "/etc/alertmanager/tls.key",
+			ServerName:            "alertmanager.example.com",
+			ProxyURL:              "http://proxy.example.com:3128",
+			Headers:               map[string]string{"X-Team": "platform"},
+		})
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		g.Expect(cfg.BasicAuth.Username).To(gomega.Equal("silence-operator"))
+		g.Expect(cfg.BasicAuth.PasswordFile).To(gomega.Equal("/etc/alertmanager/password"))
+		g.Expect(cfg.TLSConfig.CAFile).To(gomega.Equal("/etc/alertmanager/ca.crt"))
+		g.Expect(cfg.TLSConfig.CertFile).To(gomega.Equal("/etc/alertmanager/tls.crt"))
+		g.Expect(cfg.TLSConfig.KeyFile).To(gomega.Equal("/etc/alertmanager/tls.key"))
+		g.Expect(cfg.TLSConfig.ServerName).To(gomega.Equal("alertmanager.example.com"))
+		g.Expect(cfg.ProxyURL.String()).To(gomega.Equal("http://proxy.example.com:3128"))
+		g.Expect(cfg.HTTPHeaders.Headers["X-Team"].Values).To(gomega.ConsistOf("platform"))
+		g.Expect(cfg.FollowRedirects).To(gomega.BeTrue())
+	})
+
+	t.Run("file with flag overrides", func(t *testing.T) {
+		dir := t.TempDir()
+		file := filepath.Join(dir, "http.yaml")
+		g.Expect(os.WriteFile(file, []byte(`
+authorization:
+  credentials_file: token
+tls_config:
+  ca_file: ca.crt
+  server_name: alertmanager.internal
+http_headers:
+  X-Team:
+    values: [observability]
+`), 0o600)).To(gomega.Succeed())
+
+		cfg, err := LoadHTTPClientConfig(HTTPClientFlags{
+			ConfigFile: file,
+			ServerName: "alertmanager.example.com",
+			Headers:    map[string]string{"X-Source": "silence-operator"},
+		})
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		g.Expect(cfg.Authorization.Type).To(gomega.Equal("Bearer"))
+		g.Expect(cfg.Authorization.CredentialsFile).To(gomega.Equal(filepath.Join(dir, "token")))
+		g.Expect(cfg.TLSConfig.CAFile).To(gomega.Equal(filepath.Join(dir, "ca.crt")))
+		g.Expect(cfg.TLSConfig.ServerName).To(gomega.Equal("alertmanager.example.com"))
+		g.Expect(cfg.HTTPHeaders.Headers).To(gomega.HaveKey("X-Team"))
+		g.Expect(cfg.HTTPHeaders.Headers).To(gomega.HaveKey("X-Source"))
+		g.Expect(HasAuthentication(cfg)).To(gomega.BeTrue())
+	})
+
+	t.Run("missing file returns error", func(t *testing.T) {
+		cfg, err := LoadHTTPClientConfig(HTTPClientFlags{ConfigFile: filepath.Join(t.TempDir(), "missing.yaml")})
+		g.Expect(err).To(gomega.HaveOccurred())
+		g.Expect(cfg).To(gomega.BeNil())
+		g.Expect(err.Error()).To(gomega.ContainSubstring("unable to load HTTP client config file"))
+	})
+
+	t.Run("conflicting authentication returns error", func(t *testing.T) {
+		cfg, err := LoadHTTPClientConfig(HTTPClientFlags{
+			BasicAuthUsername: "silence-operator",
+			BearerTokenFile:   "/var/run/secrets/token",
+		})
+		g.Expect(err).To(gomega.HaveOccurred())
+		g.Expect(cfg).To(gomega.BeNil())
+		g.Expect(err.Error()).To(gomega.ContainSubstring("invalid HTTP client config"))
+	})
+
+	t.Run("reserved header returns error", func(t *testing.T) {
+		cfg, err := LoadHTTPClientConfig(HTTPClientFlags{Headers: map[string]string{"Authorization": "Basic Zm9v"}})
+		g.Expect(err).To(gomega.HaveOccurred())
+		g.Expect(cfg).To(gomega.BeNil())
+	})
+}
+
+func TestParseHeader(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	t.Run("valid header", func(t *testing.T) {
+		headers := map[string]string{}
+		g.Expect(ParseHeader(headers, "X-Team: platform")).To(gomega.Succeed())
+		g.Expect(headers).To(gomega.Equal(map[string]string{"X-Team": "platform"}))
+	})
+
+	t.Run("missing separator returns error", func(t *testing.T) {
+		err := ParseHeader(map[string]string{}, "X-Team")
+		g.Expect(err).To(gomega.HaveOccurred())
+		g.Expect(err.Error()).To(gomega.ContainSubstring("unable to parse header"))
+	})
+
+	t.Run("empty name returns error", func(t *testing.T) {
+		g.Expect(ParseHeader(map[string]string{}, ": platform")).ToNot(gomega.Succeed())
+	})
+}
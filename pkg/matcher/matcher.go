@@ -0,0 +1,219 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package matcher converts Silence matchers of both API versions into Alertmanager
+// matchers and validates them with the rules Alertmanager applies to new silences.
+package matcher
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/giantswarm/silence-operator/api/v1alpha1"
+	"github.com/giantswarm/silence-operator/api/v1alpha2"
+	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+)
+
+// ConvertV1alpha1 converts v1alpha1 matchers. IsEqual defaults to true when unset.
+func ConvertV1alpha1(silenceMatchers []v1alpha1.Matcher) []alertmanager.Matcher {
+	var matchers []alertmanager.Matcher
+	for _, m := range silenceMatchers {
+		isEqual := true
+		if m.IsEqual != nil {
+			isEqual = *m.IsEqual
+		}
+		matchers = append(matchers, alertmanager.Matcher{
+			IsEqual: isEqual,
+			IsRegex: m.IsRegex,
+			Name:    m.Name,
+			Value:   m.Value,
+		})
+	}
+	return matchers
+}
+
+// ConvertV1alpha2 converts v1alpha2 matchers. An empty MatchType is treated as MatchEqual.
+func ConvertV1alpha2(silenceMatchers []v1alpha2.SilenceMatcher) ([]alertmanager.Matcher, error) {
+	var matchers []alertmanager.Matcher
+	for _, m := range silenceMatchers {
+		var isRegex, isEqual bool
+
+		matchType := m.MatchType
+		if matchType == "" {
+			matchType = v1alpha2.MatchEqual
+		}
+
+		switch matchType {
+		case v1alpha2.MatchEqual:
+			isRegex = false
+			isEqual = true
+		case v1alpha2.MatchNotEqual:
+			isRegex = false
+			isEqual = false
+		case v1alpha2.MatchRegexMatch:
+			isRegex = true
+			isEqual = true
+		case v1alpha2.MatchRegexNotMatch:
+			isRegex = true
+			isEqual = false
+		default:
+			return nil, errors.Errorf("unsupported match type: %s", matchType)
+		}
+
+		matchers = append(matchers, alertmanager.Matcher{
+			IsRegex: isRegex,
+			IsEqual: isEqual,
+			Name:    m.Name,
+			Value:   m.Value,
+		})
+	}
+
+	return matchers, nil
+}
+
+// Compile returns the regular expression Alertmanager evaluates for a regex matcher.
+// Alertmanager anchors the expression at both ends and uses Go's RE2 syntax.
+func Compile(value string) (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + value + ")$")
+}
+
+// MatchesValue reports whether a label value satisfies the matcher. A missing label
+// is evaluated as the empty string, as Alertmanager does. Invalid regular expressions
+// never match.
+func MatchesValue(m alertmanager.Matcher, value string) bool {
+	var matches bool
+	if m.IsRegex {
+		re, err := Compile(m.Value)
+		if err != nil {
+			return false
+		}
+		matches = re.MatchString(value)
+	} else {
+		matches = m.Value == value
+	}
+	return matches == m.IsEqual
+}
+
+// MatchesAlert reports whether an alert with the given labels satisfies all matchers.
+func MatchesAlert(matchers []alertmanager.Matcher, labels map[string]string) bool {
+	for _, m := range matchers {
+		if !MatchesValue(m, labels[m.Name]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Operator returns the Alertmanager operator symbol of the matcher.
+func Operator(m alertmanager.Matcher) string {
+	switch {
+	case m.IsRegex && m.IsEqual:
+		return string(v1alpha2.MatchRegexMatch)
+	case m.IsRegex:
+		return string(v1alpha2.MatchRegexNotMatch)
+	case m.IsEqual:
+		return string(v1alpha2.MatchEqual)
+	default:
+		return string(v1alpha2.MatchNotEqual)
+	}
+}
+
+// String renders the matcher the way Alertmanager displays it, e.g. alertname="Foo".
+func String(m alertmanager.Matcher) string {
+	return fmt.Sprintf("%s%s%q", m.Name, Operator(m), m.Value)
+}
+
+// Validate checks matchers the way Alertmanager does before it accepts a silence, and
+// additionally rejects duplicate matchers and matchers that can never be satisfied
+// together. fldPath is the path of the matchers list in the validated object.
+func Validate(matchers []alertmanager.Matcher, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(matchers) == 0 {
+		return append(allErrs, field.Required(fldPath, "at least one matcher is required"))
+	}
+
+	valid := make([]bool, len(matchers))
+	for i, m := range matchers {
+		if m.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i).Child("name"), "label name must not be empty"))
+			continue
+		}
+		if m.IsRegex {
+			if _, err := Compile(m.Value); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("value"), m.Value, fmt.Sprintf("invalid regular expression: %v", err)))
+				continue
+			}
+		}
+		valid[i] = true
+	}
+
+	for i, m := range matchers {
+		if !valid[i] {
+			continue
+		}
+		for j := 0; j < i; j++ {
+			if !valid[j] {
+				continue
+			}
+			other := matchers[j]
+			if m == other {
+				allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), String(m)))
+				break
+			}
+			if conflicts(m, other) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Index(i), String(m), fmt.Sprintf("conflicts with %s, no alert can match both", String(other))))
+				break
+			}
+		}
+	}
+
+	// Alertmanager refuses silences that would mute alerts lacking every matched
+	// label. This covers silences built only from negative matchers such as team!="a".
+	allMatchEmpty := true
+	for i, m := range matchers {
+		if !valid[i] || !MatchesValue(m, "") {
+			allMatchEmpty = false
+			break
+		}
+	}
+	if allMatchEmpty {
+		allErrs = append(allErrs, field.Invalid(fldPath, len(matchers), "at least one matcher must not match the empty string"))
+	}
+
+	return allErrs
+}
+
+// conflicts reports whether two matchers on the same label can never be satisfied by
+// the same alert.
+func conflicts(a, b alertmanager.Matcher) bool {
+	if a.Name != b.Name {
+		return false
+	}
+	// An equality matcher pins the label value, so every other matcher on the same
+	// label has to accept that value.
+	if !a.IsRegex && a.IsEqual {
+		return !MatchesValue(b, a.Value)
+	}
+	if !b.IsRegex && b.IsEqual {
+		return !MatchesValue(a, b.Value)
+	}
+	// A regex and its negation exclude each other.
+	return a.IsRegex && b.IsRegex && a.Value == b.Value && a.IsEqual != b.IsEqual
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package matcher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/giantswarm/silence-operator/api/v1alpha1"
+	"github.com/giantswarm/silence-operator/api/v1alpha2"
+	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+)
+
+func equal(name, value string) alertmanager.Matcher {
+	return alertmanager.Matcher{Name: name, Value: value, IsEqual: true}
+}
+
+func notEqual(name, value string) alertmanager.Matcher {
+	return alertmanager.Matcher{Name: name, Value: value}
+}
+
+func regex(name, value string) alertmanager.Matcher {
+	return alertmanager.Matcher{Name: name, Value: value, IsRegex: true, IsEqual: true}
+}
+
+func notRegex(name, value string) alertmanager.Matcher {
+	return alertmanager.Matcher{Name: name, Value: value, IsRegex: true}
+}
+
+func TestConvertV1alpha1(t *testing.T) {
+	notEqualFlag := false
+	matchers := ConvertV1alpha1([]v1alpha1.Matcher{
+		{Name: "alertname", Value: "Foo"},
+		{Name: "team", Value: "a", IsEqual: &notEqualFlag},
+		{Name: "instance", Value: ".*prod.*", IsRegex: true},
+	})
+
+	assert.Equal(t, []alertmanager.Matcher{
+		equal("alertname", "Foo"),
+		notEqual("team", "a"),
+		regex("instance", ".*prod.*"),
+	}, matchers)
+}
+
+func TestConvertV1alpha2(t *testing.T) {
+	matchers, err := ConvertV1alpha2([]v1alpha2.SilenceMatcher{
+		{Name: "alertname", Value: "Foo"},
+		{Name: "alertname", Value: "Foo", MatchType: v1alpha2.MatchEqual},
+		{Name: "team", Value: "a", MatchType: v1alpha2.MatchNotEqual},
+		{Name: "instance", Value: ".*prod.*", MatchType: v1alpha2.MatchRegexMatch},
+		{Name: "env", Value: "test.*", MatchType: v1alpha2.MatchRegexNotMatch},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []alertmanager.Matcher{
+		equal("alertname", "Foo"),
+		equal("alertname", "Foo"),
+		notEqual("team", "a"),
+		regex("instance", ".*prod.*"),
+		notRegex("env", "test.*"),
+	}, matchers)
+
+	_, err = ConvertV1alpha2([]v1alpha2.SilenceMatcher{{Name: "alertname", Value: "Foo", MatchType: "=="}})
+	assert.Error(t, err)
+}
+
+func TestMatchesValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		matcher alertmanager.Matcher
+		value   string
+		want    bool
+	}{
+		{name: "equal", matcher: equal("a", "x"), value: "x", want: true},
+		{name: "equal mismatch", matcher: equal("a", "x"), value: "y", want: false},
+		{name: "not equal", matcher: notEqual("a", "x"), value: "y", want: true},
+		{name: "regex is anchored", matcher: regex("a", "pro"), value: "prod", want: false},
+		{name: "regex match", matcher: regex("a", "pro.*"), value: "prod", want: true},
+		{name: "regex alternation is anchored as a whole", matcher: regex("a", "x|y"), value: "xy", want: false},
+		{name: "negated regex", matcher: notRegex("a", "pro.*"), value: "dev", want: true},
+		{name: "invalid regex never matches", matcher: regex("a", "("), value: "(", want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, MatchesValue(tc.matcher, tc.value))
+		})
+	}
+}
+
+func TestMatchesAlert(t *testing.T) {
+	matchers := []alertmanager.Matcher{equal("alertname", "Foo"), notRegex("env", "dev.*")}
+
+	assert.True(t, MatchesAlert(matchers, map[string]string{"alertname": "Foo", "env": "prod"}))
+	assert.True(t, MatchesAlert(matchers, map[string]string{"alertname": "Foo"}))
+	assert.False(t, MatchesAlert(matchers, map[string]string{"alertname": "Foo", "env": "dev-1"}))
+	assert.False(t, MatchesAlert(matchers, map[string]string{"alertname": "Bar"}))
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		matchers []alertmanager.Matcher
+		wantErrs []string
+	}{
+		{
+			name:     "valid matchers",
+			matchers: []alertmanager.Matcher{equal("alertname", "Foo"), regex("instance", ".*prod.*"), notEqual("team", "a")},
+		},
+		{
+			name:     "no matchers",
+			wantErrs: []string{"at least one matcher is required"},
+		},
+		{
+			name:     "invalid regex",
+			matchers: []alertmanager.Matcher{equal("alertname", "Foo"), regex("instance", "prod(")},
+			wantErrs: []string{"spec.matchers[1].value", "invalid regular expression"},
+		},
+		{
+			name:     "lookahead is not supported by RE2",
+			matchers: []alertmanager.Matcher{regex("instance", "(?!prod).*")},
+			wantErrs: []string{"invalid regular expression"},
+		},
+		{
+			name:     "duplicate matcher",
+			matchers: []alertmanager.Matcher{equal("alertname", "Foo"), equal("alertname", "Foo")},
+			wantErrs: []string{"spec.matchers[1]", "Duplicate value"},
+		},
+		{
+			name:     "two different values for the same label",
+			matchers: []alertmanager.Matcher{equal("alertname", "Foo"), equal("alertname", "Bar")},
+			wantErrs: []string{"conflicts with alertname=\"Foo\""},
+		},
+		{
+			name:     "equal and not equal on the same value",
+			matchers: []alertmanager.Matcher{equal("team", "a"), notEqual("team", "a")},
+			wantErrs: []string{"conflicts"},
+		},
+		{
+			name:     "equal value rejected by regex on the same label",
+			matchers: []alertmanager.Matcher{regex("team", "b.*"), equal("team", "a")},
+			wantErrs: []string{"conflicts"},
+		},
+		{
+			name:     "regex and its negation",
+			matchers: []alertmanager.Matcher{regex("team", "a.*"), notRegex("team", "a.*")},
+			wantErrs: []string{"conflicts"},
+		},
+		{
+			name:     "compatible matchers on the same label",
+			matchers: []alertmanager.Matcher{equal("team", "alpha"), regex("team", "a.*"), notEqual("team", "beta")},
+		},
+		{
+			name:     "only negative matchers",
+			matchers: []alertmanager.Matcher{notEqual("team", "a"), notRegex("env", "prod.*")},
+			wantErrs: []string{"at least one matcher must not match the empty string"},
+		},
+		{
+			name:     "only empty-matching matchers",
+			matchers: []alertmanager.Matcher{equal("team", ""), regex("env", ".*")},
+			wantErrs: []string{"at least one matcher must not match the empty string"},
+		},
+		{
+			name:     "negative matcher on the empty string",
+			matchers: []alertmanager.Matcher{notEqual("team", "")},
+		},
+		{
+			name:     "empty label name",
+			matchers: []alertmanager.Matcher{equal("", "Foo")},
+			wantErrs: []string{"label name must not be empty"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := Validate(tc.matchers, field.NewPath("spec", "matchers"))
+			if len(tc.wantErrs) == 0 {
+				assert.Empty(t, errs)
+				return
+			}
+			require.NotEmpty(t, errs)
+			for _, want := range tc.wantErrs {
+				assert.Contains(t, errs.ToAggregate().Error(), want)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics defines the Prometheus metrics of the operator. They are registered
+// with the controller-runtime registry and served on the manager's metrics endpoint.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const namespace = "silence_operator"
+
+// Outcomes reported by SilenceSyncs.
+const (
+	// SyncOutcomeCreated is used when a silence was created in Alertmanager.
+	SyncOutcomeCreated = "created"
+	// SyncOutcomeUpdated is used when an existing Alertmanager silence was updated.
+	SyncOutcomeUpdated = "updated"
+	// SyncOutcomeDeleted is used when an Alertmanager silence was deleted as it has ended.
+	SyncOutcomeDeleted = "deleted"
+	// SyncOutcomeNoop is used when the Alertmanager silence was already up to date, or
+	// was not created as it has ended.
+	SyncOutcomeNoop = "noop"
+	// SyncOutcomeError is used when the sync failed.
+	SyncOutcomeError = "error"
+)
+
+// Reasons reported by DriftDetected.
+const (
+	// DriftReasonMissing is used when the Alertmanager silence of a Silence is gone.
+	DriftReasonMissing = "missing"
+	// DriftReasonChanged is used when the Alertmanager silence of a Silence differs from it.
+	DriftReasonChanged = "changed"
+)
+
+var (
+	// AlertmanagerRequests counts the requests sent to Alertmanager.
+	AlertmanagerRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "alertmanager",
+		Name:      "requests_total",
+		Help:      "Number of requests sent to Alertmanager, by HTTP method, status code and tenant.",
+	}, []string{"method", "code", "tenant"})
+
+	// AlertmanagerRequestDuration observes how long Alertmanager takes to respond.
+	AlertmanagerRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "alertmanager",
+		Name:      "request_duration_seconds",
+		Help:      "Time until Alertmanager responded to a request, by HTTP method, status code and tenant.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code", "tenant"})
+
+	// AlertmanagerCircuitBreakerState is the state of the circuit breaker of an Alertmanager.
+	AlertmanagerCircuitBreakerState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "alertmanager",
+		Name:      "circuit_breaker_state",
+		Help:      "State of the circuit breaker of an Alertmanager, by AlertmanagerTarget (empty for the default Alertmanager): 0 closed, 1 half-open, 2 open.",
+	}, []string{"target"})
+
+	// AlertmanagerShortCircuitedCalls counts the calls rejected by an open circuit breaker.
+	AlertmanagerShortCircuitedCalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "alertmanager",
+		Name:      "short_circuited_calls_total",
+		Help:      "Number of calls to an Alertmanager rejected by its open circuit breaker, by AlertmanagerTarget (empty for the default Alertmanager).",
+	}, []string{"target"})
+
+	// AlertmanagerReady is the outcome of the last readiness check of the default Alertmanager.
+	AlertmanagerReady = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "alertmanager",
+		Name:      "ready",
+		Help:      "Whether the default Alertmanager was ready and the silences of the default tenant could be listed at the last readiness check: 1 ready, 0 not ready.",
+	})
+
+	// SilenceSyncs counts the outcomes of syncing silences to Alertmanager.
+	SilenceSyncs = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "silence",
+		Name:      "syncs_total",
+		Help:      "Number of silences synced to Alertmanager, by outcome.",
+	}, []string{"outcome"})
+
+	// Silences describes the number of v1alpha2 Silences managed by the operator. It is
+	// collected from the Silences when the metrics are scraped.
+	Silences = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "silences"),
+		"Number of v1alpha2 Silences managed by the operator, by namespace and state.",
+		[]string{"namespace", "state"}, nil,
+	)
+)
+
+var (
+	// DriftDetected counts the Silences enqueued because their Alertmanager silence was
+	// found missing or changed.
+	DriftDetected = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "drift",
+		Name:      "detected_total",
+		Help:      "Number of times a Silence was found out of sync with its Alertmanager silence, by reason.",
+	}, []string{"reason"})
+
+	// DriftPolls counts the Alertmanager tenants polled by drift detection.
+	DriftPolls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "drift",
+		Name:      "polls_total",
+		Help:      "Number of times drift detection listed the silences of an Alertmanager tenant, by result.",
+	}, []string{"result"})
+)
+
+var (
+	// OrphanSweeps counts the sweeps for orphaned Alertmanager silences.
+	OrphanSweeps = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "orphan",
+		Name:      "sweeps_total",
+		Help:      "Number of sweeps for Alertmanager silences without a Silence, by result.",
+	}, []string{"result"})
+
+	// OrphanedSilences is the number of orphaned Alertmanager silences past their grace
+	// period found by the last sweep, including the ones it expired.
+	OrphanedSilences = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "orphan",
+		Name:      "silences",
+		Help:      "Number of Alertmanager silences without a Silence past their grace period found by the last sweep.",
+	})
+
+	// OrphanedSilencesExpired counts the orphaned Alertmanager silences expired by sweeps.
+	OrphanedSilencesExpired = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "orphan",
+		Name:      "silences_expired_total",
+		Help:      "Number of Alertmanager silences without a Silence that were expired.",
+	})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(AlertmanagerRequests, AlertmanagerRequestDuration, AlertmanagerCircuitBreakerState,
+		AlertmanagerShortCircuitedCalls, AlertmanagerReady, SilenceSyncs,
+		DriftDetected, DriftPolls, OrphanSweeps, OrphanedSilences, OrphanedSilencesExpired)
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+)
+
+// silenceCache keeps a snapshot of the silences of each target and tenant, so that
+// reconciliations share list requests instead of issuing one each. Snapshots are
+// refreshed once they are older than freshness and dropped whenever the operator writes
+// to the tenant.
+type silenceCache struct {
+	freshness time.Duration
+	now       func() time.Time
+
+	mu      sync.Mutex
+	entries map[cacheKey]*cacheEntry
+}
+
+type cacheKey struct {
+	target string
+	tenant string
+}
+
+type cacheEntry struct {
+	// mu serializes list requests, so concurrent misses share one request, and makes
+	// invalidations wait for requests in flight, so their result cannot outlive a write.
+	mu        sync.Mutex
+	silences  []alertmanager.Silence
+	fetchedAt time.Time
+	valid     bool
+}
+
+// newSilenceCache returns a cache keeping snapshots for freshness, or nil when freshness
+// is not positive.
+func newSilenceCache(freshness time.Duration) *silenceCache {
+	if freshness <= 0 {
+		return nil
+	}
+	return &silenceCache{
+		freshness: freshness,
+		now:       time.Now,
+		entries:   map[cacheKey]*cacheEntry{},
+	}
+}
+
+func (c *silenceCache) entry(target Target) *cacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := cacheKey{target: target.Name, tenant: target.Tenant}
+	e, ok := c.entries[key]
+	if !ok {
+		e = &cacheEntry{}
+		c.entries[key] = e
+	}
+	return e
+}
+
+// silences returns the snapshot of the silences of the target's tenant, listing them
+// when the snapshot is missing or stale. The result must not be modified.
+func (c *silenceCache) silences(ctx context.Context, target Target) ([]alertmanager.Silence, error) {
+	e := c.entry(target)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.valid && c.now().Sub(e.fetchedAt) < c.freshness {
+		return e.silences, nil
+	}
+	return c.fetch(ctx, e, target)
+}
+
+// refresh lists the silences of the target's tenant and replaces the snapshot with them.
+// The result must not be modified.
+func (c *silenceCache) refresh(ctx context.Context, target Target) ([]alertmanager.Silence, error) {
+	e := c.entry(target)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return c.fetch(ctx, e, target)
+}
+
+// fetch lists the silences of the target's tenant into e, which must be locked.
+func (c *silenceCache) fetch(ctx context.Context, e *cacheEntry, target Target) ([]alertmanager.Silence, error) {
+	fetchedAt := c.now()
+	silences, err := target.client.ListSilences(ctx, target.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	e.silences, e.fetchedAt, e.valid = silences, fetchedAt, true
+	return silences, nil
+}
+
+// invalidate drops the snapshot of the target's tenant.
+func (c *silenceCache) invalidate(target Target) {
+	e := c.entry(target)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.silences, e.valid = nil, false
+}
+
+// invalidateTarget drops the snapshots of all tenants of the target called name.
+func (c *silenceCache) invalidateTarget(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.entries {
+		if key.target == name {
+			delete(c.entries, key)
+		}
+	}
+}
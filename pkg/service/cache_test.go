@@ -0,0 +1,152 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+)
+
+// fakeClient is an in-memory Alertmanager counting the requests listing silences.
+type fakeClient struct {
+	alertmanager.Client
+
+	silences     map[string]alertmanager.Silence
+	listRequests map[string]int
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{silences: map[string]alertmanager.Silence{}, listRequests: map[string]int{}}
+}
+
+func (c *fakeClient) ListSilences(ctx context.Context, tenant string, filter ...alertmanager.Matcher) ([]alertmanager.Silence, error) {
+	c.listRequests[tenant]++
+	var silences []alertmanager.Silence
+	for _, silence := range c.silences {
+		silences = append(silences, silence)
+	}
+	return silences, nil
+}
+
+func (c *fakeClient) CreateSilence(ctx context.Context, s *alertmanager.Silence, tenant string) (string, error) {
+	if s.ID == "" {
+		s.ID = fmt.Sprintf("id-%d", len(c.silences))
+	}
+	c.silences[s.ID] = *s
+	return s.ID, nil
+}
+
+func (c *fakeClient) UpdateSilence(ctx context.Context, s *alertmanager.Silence, tenant string) (string, error) {
+	return c.CreateSilence(ctx, s, tenant)
+}
+
+func (c *fakeClient) DeleteSilenceByID(ctx context.Context, id string, tenant string) error {
+	delete(c.silences, id)
+	return nil
+}
+
+var testEndsAt = time.Now().Add(time.Hour)
+
+func newTestSilence(name string) *alertmanager.Silence {
+	return &alertmanager.Silence{
+		Comment:   "silence-operator-" + name,
+		CreatedBy: alertmanager.CreatedBy,
+		EndsAt:    testEndsAt,
+		Matchers:  []alertmanager.Matcher{{Name: "alertname", Value: name, IsEqual: true}},
+	}
+}
+
+func TestSilenceService_Cache(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeClient()
+	s := NewSilenceService(client, time.Minute)
+
+	now := time.Now()
+	s.cache.now = func() time.Time { return now }
+
+	for _, name := range []string{"a", "b", "c"} {
+		_, err := client.CreateSilence(ctx, newTestSilence(name), "")
+		require.NoError(t, err)
+	}
+
+	// Syncing unchanged silences shares one list request.
+	for _, name := range []string{"a", "b", "c"} {
+		result, err := s.SyncSilence(ctx, newTestSilence(name), "")
+		require.NoError(t, err)
+		assert.NotEmpty(t, result.SilenceID)
+	}
+	assert.Equal(t, 1, client.listRequests[""])
+
+	// Tenants are cached separately.
+	_, err := s.SyncSilence(ctx, newTestSilence("a"), "tenant-a")
+	require.NoError(t, err)
+	assert.Equal(t, 1, client.listRequests["tenant-a"])
+
+	// Writes drop the snapshot, so the created silence is found afterwards.
+	result, err := s.SyncSilence(ctx, newTestSilence("d"), "")
+	require.NoError(t, err)
+	_, err = s.SyncSilence(ctx, newTestSilence("d"), "")
+	require.NoError(t, err)
+	assert.Equal(t, 2, client.listRequests[""])
+	assert.Len(t, client.silences, 4)
+
+	// Stale snapshots are refreshed.
+	now = now.Add(time.Minute)
+	_, err = s.SyncSilence(ctx, newTestSilence("a"), "")
+	require.NoError(t, err)
+	assert.Equal(t, 3, client.listRequests[""])
+
+	// Deleting by ID finds the silence in the snapshot.
+	err = s.DeleteSilence(ctx, newTestSilence("d").Comment, result.SilenceID, "")
+	require.NoError(t, err)
+	assert.Len(t, client.silences, 3)
+	assert.Equal(t, 3, client.listRequests[""])
+
+	// Deleting a silence that is gone succeeds.
+	err = s.DeleteSilence(ctx, newTestSilence("d").Comment, result.SilenceID, "")
+	require.NoError(t, err)
+	assert.Equal(t, 4, client.listRequests[""])
+}
+
+func TestSilenceCache_ConcurrentMisses(t *testing.T) {
+	client := newFakeClient()
+	cache := newSilenceCache(time.Minute)
+	target := Target{Tenant: "tenant-a", client: client}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			_, err := cache.silences(context.Background(), target)
+			assert.NoError(t, err)
+		})
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, client.listRequests["tenant-a"])
+}
+
+func TestNewSilenceCache_Disabled(t *testing.T) {
+	assert.Nil(t, newSilenceCache(0))
+}
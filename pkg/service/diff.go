@@ -0,0 +1,97 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+	"github.com/giantswarm/silence-operator/pkg/matcher"
+)
+
+// timeTolerance is how far the start and end of a silence in Alertmanager may be off the
+// desired ones without an update, as Alertmanager does not return them with the precision
+// they were sent with.
+const timeTolerance = time.Second
+
+// Diff describes how desired differs from the existing Alertmanager silence, one change
+// per entry, e.g. `matchers {alertname="A"} -> {alertname="B"}`. It is empty when the
+// silence is up to date. Matchers are compared regardless of their order and times within
+// timeTolerance. The start is only compared while either start is after now, as
+// Alertmanager starts silences sent with a past start when it receives them.
+func Diff(existing, desired *alertmanager.Silence, now time.Time) []string {
+	var changes []string
+
+	existingMatchers, desiredMatchers := sortedMatchers(existing.Matchers), sortedMatchers(desired.Matchers)
+	if !slices.Equal(existingMatchers, desiredMatchers) {
+		changes = append(changes, fmt.Sprintf("matchers %s -> %s", formatMatchers(existingMatchers), formatMatchers(desiredMatchers)))
+	}
+	if (existing.StartsAt.After(now) || desired.StartsAt.After(now)) && !timesEqual(existing.StartsAt, desired.StartsAt) {
+		changes = append(changes, fmt.Sprintf("startsAt %s -> %s", formatTime(existing.StartsAt), formatTime(desired.StartsAt)))
+	}
+	if !timesEqual(existing.EndsAt, desired.EndsAt) {
+		changes = append(changes, fmt.Sprintf("endsAt %s -> %s", formatTime(existing.EndsAt), formatTime(desired.EndsAt)))
+	}
+	if existing.Comment != desired.Comment {
+		changes = append(changes, "comment changed")
+	}
+	return changes
+}
+
+// sortedMatchers returns a sorted copy of matchers.
+func sortedMatchers(matchers []alertmanager.Matcher) []alertmanager.Matcher {
+	return slices.SortedFunc(slices.Values(matchers), func(a, b alertmanager.Matcher) int {
+		return cmp.Or(
+			cmp.Compare(a.Name, b.Name),
+			cmp.Compare(a.Value, b.Value),
+			compareBool(a.IsRegex, b.IsRegex),
+			compareBool(a.IsEqual, b.IsEqual),
+		)
+	})
+}
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	}
+	return -1
+}
+
+func timesEqual(a, b time.Time) bool {
+	return a.Sub(b).Abs() < timeTolerance
+}
+
+// formatMatchers renders matchers the way Alertmanager displays them, e.g. {alertname="Foo", severity=~"warn|crit"}.
+func formatMatchers(matchers []alertmanager.Matcher) string {
+	rendered := make([]string, 0, len(matchers))
+	for _, m := range matchers {
+		rendered = append(rendered, matcher.String(m))
+	}
+	return "{" + strings.Join(rendered, ", ") + "}"
+}
+
+// formatTime renders t in UTC with second precision.
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
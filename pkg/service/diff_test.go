@@ -0,0 +1,130 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+)
+
+func TestDiff(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	alertname := alertmanager.Matcher{Name: "alertname", Value: "Foo", IsEqual: true}
+	severity := alertmanager.Matcher{Name: "severity", Value: "warn|crit", IsRegex: true, IsEqual: true}
+	existing := func() *alertmanager.Silence {
+		return &alertmanager.Silence{
+			Comment:  "silence-operator-foo",
+			StartsAt: now.Add(-time.Hour),
+			EndsAt:   now.Add(time.Hour),
+			Matchers: []alertmanager.Matcher{alertname, severity},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		desired func(s *alertmanager.Silence)
+		changes []string
+	}{
+		{
+			name:    "unchanged",
+			desired: func(s *alertmanager.Silence) {},
+		},
+		{
+			name: "matchers reordered",
+			desired: func(s *alertmanager.Silence) {
+				s.Matchers = []alertmanager.Matcher{severity, alertname}
+			},
+		},
+		{
+			name: "matcher changed",
+			desired: func(s *alertmanager.Silence) {
+				s.Matchers = []alertmanager.Matcher{severity, {Name: "alertname", Value: "Bar", IsEqual: true}}
+			},
+			changes: []string{`matchers {alertname="Foo", severity=~"warn|crit"} -> {alertname="Bar", severity=~"warn|crit"}`},
+		},
+		{
+			name: "matcher operator changed",
+			desired: func(s *alertmanager.Silence) {
+				s.Matchers = []alertmanager.Matcher{{Name: "alertname", Value: "Foo"}, severity}
+			},
+			changes: []string{`matchers {alertname="Foo", severity=~"warn|crit"} -> {alertname!="Foo", severity=~"warn|crit"}`},
+		},
+		{
+			name: "end within tolerance",
+			desired: func(s *alertmanager.Silence) {
+				s.EndsAt = s.EndsAt.Add(500 * time.Millisecond)
+			},
+		},
+		{
+			name: "end changed",
+			desired: func(s *alertmanager.Silence) {
+				s.EndsAt = s.EndsAt.Add(time.Hour)
+			},
+			changes: []string{"endsAt 2026-01-01T13:00:00Z -> 2026-01-01T14:00:00Z"},
+		},
+		{
+			name: "past start changed",
+			desired: func(s *alertmanager.Silence) {
+				s.StartsAt = now.Add(-time.Minute)
+			},
+		},
+		{
+			name: "start moved to the future",
+			desired: func(s *alertmanager.Silence) {
+				s.StartsAt = now.Add(time.Minute)
+			},
+			changes: []string{"startsAt 2026-01-01T11:00:00Z -> 2026-01-01T12:01:00Z"},
+		},
+		{
+			name: "comment changed",
+			desired: func(s *alertmanager.Silence) {
+				s.Comment = "silence-operator-bar"
+			},
+			changes: []string{"comment changed"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desired := existing()
+			tt.desired(desired)
+			assert.Equal(t, tt.changes, Diff(existing(), desired, now))
+		})
+	}
+
+	t.Run("future start changed", func(t *testing.T) {
+		pending := existing()
+		pending.StartsAt = now.Add(time.Hour)
+		pending.EndsAt = now.Add(2 * time.Hour)
+		desired := *pending
+		desired.StartsAt = now.Add(90 * time.Minute)
+		assert.Equal(t, []string{"startsAt 2026-01-01T13:00:00Z -> 2026-01-01T13:30:00Z"}, Diff(pending, &desired, now))
+
+		desired.StartsAt = pending.StartsAt.Add(-time.Millisecond)
+		assert.Empty(t, Diff(pending, &desired, now))
+	})
+
+	t.Run("does not reorder the matchers", func(t *testing.T) {
+		desired := existing()
+		desired.Matchers = []alertmanager.Matcher{severity, alertname}
+		Diff(existing(), desired, now)
+		assert.Equal(t, []alertmanager.Matcher{severity, alertname}, desired.Matchers)
+	})
+}
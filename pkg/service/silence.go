@@ -18,70 +18,360 @@ package service
 
 import (
 	"context"
-	"reflect"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+	"github.com/giantswarm/silence-operator/pkg/config"
+	"github.com/giantswarm/silence-operator/pkg/matcher"
+	"github.com/giantswarm/silence-operator/pkg/metrics"
 )
 
 // SilenceService provides business logic for managing silences
 type SilenceService struct {
 	alertmanager alertmanager.Client
+
+	// targets caches one client per AlertmanagerTarget.
+	targetsMu sync.Mutex
+	targets   map[string]targetClient
+	newClient func(config.Config) (alertmanager.Client, error)
+	// guard wraps the clients when set.
+	guard *alertmanager.Guard
+
+	// cache is nil when silences are not cached.
+	cache *silenceCache
 }
 
-// NewSilenceService creates a new silence service
-func NewSilenceService(alertmanager alertmanager.Client) *SilenceService {
+// NewSilenceService creates a new silence service. Silences listed from Alertmanager are
+// shared between calls for cacheFreshness; zero disables the cache.
+func NewSilenceService(alertmanager alertmanager.Client, cacheFreshness time.Duration) *SilenceService {
 	return &SilenceService{
 		alertmanager: alertmanager,
+		targets:      map[string]targetClient{},
+		newClient:    newAlertmanagerClient,
+		cache:        newSilenceCache(cacheFreshness),
+	}
+}
+
+// UseGuard guards the default Alertmanager and the AlertmanagerTargets created afterwards with
+// the rate limiter and circuit breakers of guard. It must be called before the service is used.
+func (s *SilenceService) UseGuard(guard *alertmanager.Guard) {
+	s.guard = guard
+	s.alertmanager = guard.Wrap("", s.alertmanager)
+}
+
+// SyncResult describes the Alertmanager silence after a successful SyncSilence call.
+type SyncResult struct {
+	// SilenceID is the ID of the silence in Alertmanager. It is empty when the silence
+	// has expired, or was never created because it already ended.
+	SilenceID string
+	// Outcome is what the sync did, one of the metrics.SyncOutcome values other than
+	// metrics.SyncOutcomeError.
+	Outcome string
+	// Previous is the Alertmanager silence before it was updated or deleted.
+	Previous *alertmanager.Silence
+	// Changes describes how an updated silence differed from the desired one, see Diff.
+	Changes []string
+	// ReplacedID is the ID of the silence Alertmanager expired when the update created a
+	// new silence instead of changing it in place, as it does when the matchers of an
+	// active silence change. It is empty otherwise.
+	ReplacedID string
+}
+
+// SyncSilence handles the creation or update of a silence in the default Alertmanager
+func (s *SilenceService) SyncSilence(ctx context.Context, newSilence *alertmanager.Silence, tenant string) (SyncResult, error) {
+	return s.SyncSilenceToTarget(ctx, newSilence, s.DefaultTarget(tenant))
+}
+
+// SyncSilenceToTarget handles the creation or update of a silence in the given target.
+// newSilence.ID is the ID the silence was last synced with, if known, and is used to
+// look up the existing silence without listing all silences.
+// newSilence is not modified, so it can be synced to several targets.
+func (s *SilenceService) SyncSilenceToTarget(ctx context.Context, newSilence *alertmanager.Silence, target Target) (SyncResult, error) {
+	result, err := s.syncSilence(ctx, newSilence, target)
+	outcome := result.Outcome
+	if err != nil {
+		outcome = metrics.SyncOutcomeError
 	}
+	metrics.SilenceSyncs.WithLabelValues(outcome).Inc()
+	return result, err
 }
 
-// SyncSilence handles the creation or update of a silence
-func (s *SilenceService) SyncSilence(ctx context.Context, newSilence *alertmanager.Silence, tenant string) error {
+// syncSilence implements SyncSilenceToTarget.
+func (s *SilenceService) syncSilence(ctx context.Context, newSilence *alertmanager.Silence, target Target) (SyncResult, error) {
 	now := time.Now()
+	am, tenant := target.client, target.Tenant
 
-	// Get existing silence by comment using specified tenant
-	existingSilence, err := s.alertmanager.GetSilenceByComment(newSilence.Comment, tenant)
+	existingSilence, err := s.findSilence(ctx, newSilence, target)
 	if err != nil && !errors.Is(err, alertmanager.ErrSilenceNotFound) {
-		return errors.Wrap(err, "failed to get silence from Alertmanager")
+		return SyncResult{}, errors.Wrap(err, "failed to get silence from Alertmanager")
 	}
 
 	if errors.Is(err, alertmanager.ErrSilenceNotFound) {
-		if newSilence.EndsAt.After(now) {
-			err := s.alertmanager.CreateSilence(newSilence, tenant)
-			if err != nil {
-				return errors.Wrap(err, "failed to create silence in Alertmanager")
-			}
+		if !newSilence.EndsAt.After(now) {
+			return SyncResult{Outcome: metrics.SyncOutcomeNoop}, nil
 		}
-		return nil
+		createdSilence := *newSilence
+		createdSilence.ID = ""
+		id, err := am.CreateSilence(ctx, &createdSilence, tenant)
+		s.invalidate(target)
+		if err != nil {
+			return SyncResult{}, errors.Wrap(err, "failed to create silence in Alertmanager")
+		}
+		return SyncResult{SilenceID: id, Outcome: metrics.SyncOutcomeCreated}, nil
 	}
 
 	if newSilence.EndsAt.Before(now) {
-		err := s.alertmanager.DeleteSilenceByID(existingSilence.ID, tenant)
+		err := am.DeleteSilenceByID(ctx, existingSilence.ID, tenant)
+		s.invalidate(target)
 		if err != nil {
-			return errors.Wrap(err, "failed to delete expired silence from Alertmanager")
+			return SyncResult{}, errors.Wrap(err, "failed to delete expired silence from Alertmanager")
 		}
-		return nil
+		return SyncResult{Outcome: metrics.SyncOutcomeDeleted, Previous: existingSilence}, nil
 	}
 
-	if s.updateNeeded(existingSilence, newSilence) {
-		newSilence.ID = existingSilence.ID
-		err := s.alertmanager.UpdateSilence(newSilence, tenant)
+	if changes := Diff(existingSilence, newSilence, now); len(changes) > 0 {
+		updatedSilence := *newSilence
+		updatedSilence.ID = existingSilence.ID
+		// Alertmanager replaces active silences whose start changes, and starts silences
+		// sent with a past start when it receives them anyway.
+		if !existingSilence.StartsAt.After(now) && !newSilence.StartsAt.After(now) {
+			updatedSilence.StartsAt = existingSilence.StartsAt
+		}
+		id, err := am.UpdateSilence(ctx, &updatedSilence, tenant)
+		s.invalidate(target)
 		if err != nil {
-			return errors.Wrap(err, "failed to update silence in Alertmanager")
+			return SyncResult{}, errors.Wrap(err, "failed to update silence in Alertmanager")
 		}
-		return nil
+		result := SyncResult{SilenceID: id, Outcome: metrics.SyncOutcomeUpdated, Previous: existingSilence, Changes: changes}
+		if id != existingSilence.ID {
+			result.ReplacedID = existingSilence.ID
+		}
+		return result, nil
 	}
 
 	// No changes needed
+	return SyncResult{SilenceID: existingSilence.ID, Outcome: metrics.SyncOutcomeNoop}, nil
+}
+
+// AdoptSilence takes over the silence with the given ID in the default Alertmanager, which
+// was created outside of the operator, by updating it in place to newSilence. The start of
+// a silence that is already active is kept, as Alertmanager replaces active silences whose
+// start changes. It returns the ID of the adopted silence, which is empty when the silence
+// no longer exists or was already taken over by an installation of the operator.
+func (s *SilenceService) AdoptSilence(ctx context.Context, newSilence *alertmanager.Silence, id, tenant string) (string, error) {
+	target := s.DefaultTarget(tenant)
+
+	existingSilence, err := target.client.GetSilenceByID(ctx, id, tenant)
+	if errors.Is(err, alertmanager.ErrSilenceNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get silence from Alertmanager")
+	}
+	if alertmanager.CreatedByOperator(existingSilence.CreatedBy) {
+		return "", nil
+	}
+
+	adoptedSilence := *newSilence
+	adoptedSilence.ID = existingSilence.ID
+	if !existingSilence.StartsAt.After(time.Now()) {
+		adoptedSilence.StartsAt = existingSilence.StartsAt
+	}
+	adoptedID, err := target.client.UpdateSilence(ctx, &adoptedSilence, tenant)
+	s.invalidate(target)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to adopt silence in Alertmanager")
+	}
+	return adoptedID, nil
+}
+
+// FindLegacySilence returns the ID of the silence in the default Alertmanager that was
+// created for newSilence before identities included the UID, see
+// alertmanager.IsLegacySilence. Legacy identities are ambiguous, so only a silence with the
+// same matchers as newSilence is returned, and callers must make sure that no other
+// resource of another kind has the same legacy identity. The ID is empty when there is no
+// such silence. Syncing newSilence with the ID migrates the silence to its identity.
+func (s *SilenceService) FindLegacySilence(ctx context.Context, newSilence *alertmanager.Silence, tenant string) (string, error) {
+	if id, ok := alertmanager.ParseIdentity(newSilence.Comment); !ok || id.Instance != "" {
+		return "", nil
+	}
+
+	target := s.DefaultTarget(tenant)
+	var silences []alertmanager.Silence
+	var err error
+	if s.cache != nil {
+		silences, err = s.cache.silences(ctx, target)
+	} else {
+		silences, err = target.client.ListSilences(ctx, tenant, newSilence.Matchers...)
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "failed to list silences from Alertmanager")
+	}
+
+	matchers := sortedMatchers(newSilence.Matchers)
+	for i := range silences {
+		if alertmanager.IsLegacySilence(&silences[i], newSilence.Comment) &&
+			slices.Equal(sortedMatchers(silences[i].Matchers), matchers) {
+			return silences[i].ID, nil
+		}
+	}
+	return "", nil
+}
+
+// DeleteLegacySilences deletes the silences of the default Alertmanager that are legacy
+// silences of comment, see alertmanager.IsLegacySilence, whatever their matchers.
+func (s *SilenceService) DeleteLegacySilences(ctx context.Context, comment, tenant string) error {
+	if id, ok := alertmanager.ParseIdentity(comment); !ok || id.Instance != "" {
+		return nil
+	}
+
+	target := s.DefaultTarget(tenant)
+	var silences []alertmanager.Silence
+	var err error
+	if s.cache != nil {
+		silences, err = s.cache.silences(ctx, target)
+	} else {
+		silences, err = target.client.ListSilences(ctx, tenant)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to list silences from Alertmanager")
+	}
+
+	var deleted bool
+	for i := range silences {
+		if !alertmanager.IsLegacySilence(&silences[i], comment) {
+			continue
+		}
+		err := target.client.DeleteSilenceByID(ctx, silences[i].ID, tenant)
+		deleted = true
+		if err != nil && !errors.Is(err, alertmanager.ErrSilenceNotFound) {
+			s.invalidate(target)
+			return errors.Wrap(err, "failed to delete legacy silence from Alertmanager")
+		}
+	}
+	if deleted {
+		s.invalidate(target)
+	}
 	return nil
 }
 
-// DeleteSilence handles the deletion of a silence
-func (s *SilenceService) DeleteSilence(ctx context.Context, comment, tenant string) error {
-	err := s.alertmanager.DeleteSilenceByComment(comment, tenant)
+// findSilence returns the silence newSilence was synced to. When silences are cached, it
+// is looked up in the snapshot of the tenant. Otherwise it is fetched by newSilence.ID
+// when that is set. Otherwise, or when that silence is gone, the silences matching the
+// matchers of newSilence are scanned by comment, and then all silences, as the matchers may
+// have changed since the silence was synced.
+func (s *SilenceService) findSilence(ctx context.Context, newSilence *alertmanager.Silence, target Target) (*alertmanager.Silence, error) {
+	am, tenant := target.client, target.Tenant
+
+	if s.cache != nil {
+		silences, err := s.cache.silences(ctx, target)
+		if err != nil {
+			return nil, err
+		}
+		return findInSnapshot(silences, newSilence.ID, newSilence.Comment)
+	}
+
+	if newSilence.ID != "" {
+		existingSilence, err := am.GetSilenceByID(ctx, newSilence.ID, tenant)
+		if err != nil && !errors.Is(err, alertmanager.ErrSilenceNotFound) {
+			return nil, err
+		}
+		if err == nil && syncedTo(existingSilence, newSilence.Comment) {
+			return existingSilence, nil
+		}
+	}
+
+	existingSilence, err := am.GetSilenceByComment(ctx, newSilence.Comment, tenant, newSilence.Matchers...)
+	if !errors.Is(err, alertmanager.ErrSilenceNotFound) {
+		return existingSilence, err
+	}
+	return am.GetSilenceByComment(ctx, newSilence.Comment, tenant)
+}
+
+// findInSnapshot returns a copy of the silence with the given ID, or else with the given
+// comment identity, from a snapshot of silences.
+func findInSnapshot(silences []alertmanager.Silence, id, comment string) (*alertmanager.Silence, error) {
+	if id != "" {
+		for _, silence := range silences {
+			if silence.ID == id && syncedTo(&silence, comment) {
+				return &silence, nil
+			}
+		}
+	}
+	for _, silence := range silences {
+		if sameIdentity(&silence, comment) {
+			return &silence, nil
+		}
+	}
+	return nil, errors.WithMessagef(alertmanager.ErrSilenceNotFound, "failed to find silence with comment %#q", alertmanager.CommentIdentity(comment))
+}
+
+// invalidate drops the cached silences of the target's tenant after a write.
+func (s *SilenceService) invalidate(target Target) {
+	if s.cache != nil {
+		s.cache.invalidate(target)
+	}
+}
+
+// sameIdentity returns true when silence was created with the given comment identity by
+// the operator instance named by the comment.
+func sameIdentity(silence *alertmanager.Silence, comment string) bool {
+	return alertmanager.CommentInstance(comment).Owns(silence) &&
+		alertmanager.SameIdentity(silence.Comment, comment)
+}
+
+// syncedTo returns true when silence, found by the ID a silence with the given comment
+// identity was last synced with, is still that silence. Silences found by ID may have a
+// legacy identity, as they are migrated to the identity of the comment on sync.
+func syncedTo(silence *alertmanager.Silence, comment string) bool {
+	return sameIdentity(silence, comment) || alertmanager.IsLegacySilence(silence, comment)
+}
+
+// DeleteSilence handles the deletion of a silence from the default Alertmanager.
+// id is the ID the silence was last synced with, if known.
+func (s *SilenceService) DeleteSilence(ctx context.Context, comment, id, tenant string) error {
+	return s.DeleteSilenceFromTarget(ctx, comment, id, s.DefaultTarget(tenant))
+}
+
+// DeleteSilenceFromTarget handles the deletion of a silence from the given target.
+// id is the ID the silence was last synced with, if known.
+func (s *SilenceService) DeleteSilenceFromTarget(ctx context.Context, comment, id string, target Target) error {
+	if s.cache != nil {
+		silences, err := s.cache.silences(ctx, target)
+		if err != nil {
+			return errors.Wrap(err, "failed to list silences from Alertmanager")
+		}
+		existingSilence, err := findInSnapshot(silences, id, comment)
+		if errors.Is(err, alertmanager.ErrSilenceNotFound) {
+			return nil
+		}
+		err = target.client.DeleteSilenceByID(ctx, existingSilence.ID, target.Tenant)
+		s.invalidate(target)
+		if err != nil && !errors.Is(err, alertmanager.ErrSilenceNotFound) {
+			return errors.Wrap(err, "failed to delete silence from Alertmanager")
+		}
+		return nil
+	}
+
+	if id != "" {
+		existingSilence, err := target.client.GetSilenceByID(ctx, id, target.Tenant)
+		if err != nil && !errors.Is(err, alertmanager.ErrSilenceNotFound) {
+			return errors.Wrap(err, "failed to get silence from Alertmanager")
+		}
+		if err == nil && syncedTo(existingSilence, comment) {
+			err = target.client.DeleteSilenceByID(ctx, id, target.Tenant)
+			if err != nil && !errors.Is(err, alertmanager.ErrSilenceNotFound) {
+				return errors.Wrap(err, "failed to delete silence from Alertmanager")
+			}
+			return nil
+		}
+	}
+
+	err := target.client.DeleteSilenceByComment(ctx, comment, target.Tenant)
 	if err != nil {
 		// If the silence is already gone in Alertmanager, treat it as success
 		if errors.Is(err, alertmanager.ErrSilenceNotFound) {
@@ -94,8 +384,34 @@ func (s *SilenceService) DeleteSilence(ctx context.Context, comment, tenant stri
 	return nil
 }
 
-// updateNeeded returns true when silence needs to be updated
-func (s *SilenceService) updateNeeded(existingSilence, newSilence *alertmanager.Silence) bool {
-	return !reflect.DeepEqual(existingSilence.Matchers, newSilence.Matchers) ||
-		!existingSilence.EndsAt.Equal(newSilence.EndsAt)
+// MatchingAlerts returns the alerts known to the target's Alertmanager that match all matchers.
+func (s *SilenceService) MatchingAlerts(ctx context.Context, matchers []alertmanager.Matcher, target Target) ([]alertmanager.Alert, error) {
+	alerts, err := target.client.ListAlerts(ctx, target.Tenant)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list alerts from Alertmanager")
+	}
+
+	var matching []alertmanager.Alert
+	for _, alert := range alerts {
+		if matcher.MatchesAlert(matchers, alert.Labels) {
+			matching = append(matching, alert)
+		}
+	}
+	return matching, nil
+}
+
+// ListSilences lists the silences of the target's tenant. When silences are cached, the
+// cached snapshot is replaced with the result, which must not be modified.
+func (s *SilenceService) ListSilences(ctx context.Context, target Target) ([]alertmanager.Silence, error) {
+	var silences []alertmanager.Silence
+	var err error
+	if s.cache != nil {
+		silences, err = s.cache.refresh(ctx, target)
+	} else {
+		silences, err = target.client.ListSilences(ctx, target.Tenant)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list silences from Alertmanager")
+	}
+	return silences, nil
 }
@@ -0,0 +1,383 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+	"github.com/giantswarm/silence-operator/pkg/config"
+	"github.com/giantswarm/silence-operator/pkg/metrics"
+)
+
+func TestSilenceService_SyncOutcomes(t *testing.T) {
+	ctx := context.Background()
+	s := NewSilenceService(newFakeClient(), time.Minute)
+
+	outcomes := []string{metrics.SyncOutcomeCreated, metrics.SyncOutcomeUpdated, metrics.SyncOutcomeDeleted, metrics.SyncOutcomeNoop}
+	counts := func() map[string]float64 {
+		counts := map[string]float64{}
+		for _, outcome := range outcomes {
+			counts[outcome] = testutil.ToFloat64(metrics.SilenceSyncs.WithLabelValues(outcome))
+		}
+		return counts
+	}
+
+	steps := []struct {
+		name    string
+		endsAt  time.Time
+		outcome string
+	}{
+		{name: "create", endsAt: testEndsAt, outcome: metrics.SyncOutcomeCreated},
+		{name: "unchanged", endsAt: testEndsAt, outcome: metrics.SyncOutcomeNoop},
+		{name: "update", endsAt: testEndsAt.Add(time.Hour), outcome: metrics.SyncOutcomeUpdated},
+		{name: "ended", endsAt: time.Now().Add(-time.Minute), outcome: metrics.SyncOutcomeDeleted},
+		{name: "ended and gone", endsAt: time.Now().Add(-time.Minute), outcome: metrics.SyncOutcomeNoop},
+	}
+	for _, step := range steps {
+		before := counts()
+
+		silence := newTestSilence("outcomes")
+		silence.EndsAt = step.endsAt
+		result, err := s.SyncSilence(ctx, silence, "")
+		require.NoError(t, err, step.name)
+		assert.Equal(t, step.outcome, result.Outcome, step.name)
+		if step.outcome == metrics.SyncOutcomeUpdated || step.outcome == metrics.SyncOutcomeDeleted {
+			require.NotNil(t, result.Previous, step.name)
+			assert.NotEqual(t, silence.EndsAt, result.Previous.EndsAt, step.name)
+		}
+
+		after := counts()
+		for _, outcome := range outcomes {
+			want := before[outcome]
+			if outcome == step.outcome {
+				want++
+			}
+			assert.Equal(t, want, after[outcome], "%s: outcome %s", step.name, outcome)
+		}
+	}
+}
+
+// replacingClient is a fakeClient that, like Alertmanager, replaces active silences whose
+// matchers or start change instead of updating them in place.
+type replacingClient struct {
+	*fakeClient
+
+	updates []alertmanager.Silence
+}
+
+func (c *replacingClient) UpdateSilence(ctx context.Context, s *alertmanager.Silence, tenant string) (string, error) {
+	c.updates = append(c.updates, *s)
+	existing := c.silences[s.ID]
+	if existing.StartsAt.After(time.Now()) ||
+		(slices.Equal(sortedMatchers(existing.Matchers), sortedMatchers(s.Matchers)) && existing.StartsAt.Equal(s.StartsAt)) {
+		return c.fakeClient.UpdateSilence(ctx, s, tenant)
+	}
+	delete(c.silences, s.ID)
+	replacement := *s
+	replacement.ID = s.ID + "-replaced"
+	return c.CreateSilence(ctx, &replacement, tenant)
+}
+
+func TestSilenceService_SyncActiveSilence(t *testing.T) {
+	ctx := context.Background()
+	client := &replacingClient{fakeClient: newFakeClient()}
+	s := NewSilenceService(client, time.Minute)
+
+	silence := newTestSilence("active")
+	silence.StartsAt = time.Now().Add(-time.Hour)
+	created, err := s.SyncSilence(ctx, silence, "")
+	require.NoError(t, err)
+
+	t.Run("end changed", func(t *testing.T) {
+		desired := *silence
+		desired.ID = created.SilenceID
+		desired.StartsAt = time.Now()
+		desired.EndsAt = desired.EndsAt.Add(time.Hour)
+
+		result, err := s.SyncSilence(ctx, &desired, "")
+		require.NoError(t, err)
+		assert.Equal(t, metrics.SyncOutcomeUpdated, result.Outcome)
+		assert.Equal(t, created.SilenceID, result.SilenceID)
+		assert.Empty(t, result.ReplacedID)
+		require.Len(t, result.Changes, 1)
+		assert.Contains(t, result.Changes[0], "endsAt")
+
+		require.Len(t, client.updates, 1)
+		assert.True(t, client.updates[0].StartsAt.Equal(silence.StartsAt), "the start of the active silence is kept")
+	})
+
+	t.Run("matchers changed", func(t *testing.T) {
+		desired := *silence
+		desired.ID = created.SilenceID
+		desired.EndsAt = desired.EndsAt.Add(time.Hour)
+		desired.Matchers = []alertmanager.Matcher{{Name: "alertname", Value: "other", IsEqual: true}}
+
+		result, err := s.SyncSilence(ctx, &desired, "")
+		require.NoError(t, err)
+		assert.Equal(t, metrics.SyncOutcomeUpdated, result.Outcome)
+		assert.Equal(t, created.SilenceID+"-replaced", result.SilenceID)
+		assert.Equal(t, created.SilenceID, result.ReplacedID)
+		assert.Equal(t, []string{`matchers {alertname="active"} -> {alertname="other"}`}, result.Changes)
+
+		desired.ID = result.SilenceID
+		result, err = s.SyncSilence(ctx, &desired, "")
+		require.NoError(t, err)
+		assert.Equal(t, metrics.SyncOutcomeNoop, result.Outcome, "the replacement is found")
+	})
+
+	t.Run("matchers reordered", func(t *testing.T) {
+		silence := newTestSilence("reordered")
+		silence.Matchers = append(silence.Matchers, alertmanager.Matcher{Name: "severity", Value: "warning", IsEqual: true})
+		created, err := s.SyncSilence(ctx, silence, "")
+		require.NoError(t, err)
+
+		desired := *silence
+		desired.ID = created.SilenceID
+		desired.Matchers = []alertmanager.Matcher{silence.Matchers[1], silence.Matchers[0]}
+		result, err := s.SyncSilence(ctx, &desired, "")
+		require.NoError(t, err)
+		assert.Equal(t, metrics.SyncOutcomeNoop, result.Outcome)
+	})
+}
+
+func TestSilenceService_MigrateLegacyIdentity(t *testing.T) {
+	ctx := context.Background()
+
+	identity := alertmanager.Identity{Group: "observability.giantswarm.io", Kind: "Silence", Namespace: "ns", Name: "legacy", UID: "1234"}
+	newLegacySilence := func() *alertmanager.Silence {
+		legacy := newTestSilence("legacy")
+		legacy.ID = "legacy-id"
+		legacy.Comment = identity.Legacy()
+		return legacy
+	}
+	newDesiredSilence := func() *alertmanager.Silence {
+		desired := newTestSilence("legacy")
+		desired.Comment = identity.String()
+		return desired
+	}
+
+	client := newFakeClient()
+	s := NewSilenceService(client, time.Minute)
+	client.silences["legacy-id"] = *newLegacySilence()
+
+	desired := newDesiredSilence()
+	result, err := s.SyncSilence(ctx, desired, "")
+	require.NoError(t, err)
+	assert.Equal(t, metrics.SyncOutcomeCreated, result.Outcome, "legacy silences are not found by comment")
+	delete(client.silences, result.SilenceID)
+	s.invalidate(s.DefaultTarget(""))
+
+	desired.ID, err = s.FindLegacySilence(ctx, desired, "")
+	require.NoError(t, err)
+	assert.Equal(t, "legacy-id", desired.ID)
+
+	result, err = s.SyncSilence(ctx, desired, "")
+	require.NoError(t, err)
+	assert.Equal(t, metrics.SyncOutcomeUpdated, result.Outcome)
+	assert.Equal(t, "legacy-id", result.SilenceID)
+	assert.Equal(t, []string{"comment changed"}, result.Changes)
+	assert.Equal(t, identity.String(), client.silences["legacy-id"].Comment)
+
+	id, err := s.FindLegacySilence(ctx, desired, "")
+	require.NoError(t, err)
+	assert.Empty(t, id, "the migrated silence no longer has the legacy identity")
+
+	t.Run("not migrated", func(t *testing.T) {
+		otherMatchers := newLegacySilence()
+		otherMatchers.Matchers = append(otherMatchers.Matchers, alertmanager.Matcher{Name: "cluster", Value: "a", IsEqual: true})
+		otherCreator := newLegacySilence()
+		otherCreator.CreatedBy = "someone"
+		instance := identity
+		instance.Instance = "workload"
+		otherInstance := newDesiredSilence()
+		otherInstance.Comment = instance.String()
+
+		for name, tc := range map[string]struct {
+			legacy  *alertmanager.Silence
+			desired *alertmanager.Silence
+		}{
+			"other matchers": {legacy: otherMatchers, desired: newDesiredSilence()},
+			"other creator":  {legacy: otherCreator, desired: newDesiredSilence()},
+			"named instance": {legacy: newLegacySilence(), desired: otherInstance},
+		} {
+			client := newFakeClient()
+			client.silences["legacy-id"] = *tc.legacy
+			s := NewSilenceService(client, time.Minute)
+
+			id, err := s.FindLegacySilence(ctx, tc.desired, "")
+			require.NoError(t, err, name)
+			assert.Empty(t, id, name)
+		}
+	})
+
+	t.Run("deleted", func(t *testing.T) {
+		otherMatchers := newLegacySilence()
+		otherMatchers.Matchers = append(otherMatchers.Matchers, alertmanager.Matcher{Name: "cluster", Value: "a", IsEqual: true})
+		otherCreator := newLegacySilence()
+		otherCreator.ID = "other-creator-id"
+		otherCreator.CreatedBy = "someone"
+
+		client := newFakeClient()
+		client.silences[otherMatchers.ID] = *otherMatchers
+		client.silences[otherCreator.ID] = *otherCreator
+		s := NewSilenceService(client, time.Minute)
+
+		require.NoError(t, s.DeleteLegacySilences(ctx, identity.String(), ""))
+		assert.NotContains(t, client.silences, otherMatchers.ID, "legacy silences are deleted whatever their matchers")
+		assert.Contains(t, client.silences, otherCreator.ID)
+
+		instance := identity
+		instance.Instance = "workload"
+		client.silences[otherMatchers.ID] = *otherMatchers
+		s.invalidate(s.DefaultTarget(""))
+		require.NoError(t, s.DeleteLegacySilences(ctx, instance.String(), ""))
+		assert.Contains(t, client.silences, otherMatchers.ID, "named instances have no legacy silences")
+	})
+}
+
+func TestSilenceService_Instances(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeClient()
+	s := NewSilenceService(client, time.Minute)
+
+	identity := alertmanager.Identity{Group: "observability.giantswarm.io", Kind: "Silence", Namespace: "ns", Name: "shared", UID: "1234"}
+	newInstanceSilence := func(instance alertmanager.Instance) *alertmanager.Silence {
+		id := identity
+		id.Instance = instance
+		silence := newTestSilence("shared")
+		silence.Comment = id.String()
+		silence.CreatedBy = instance.CreatedBy()
+		return silence
+	}
+
+	legacy := newTestSilence("shared")
+	legacy.ID = "legacy-id"
+	legacy.Comment = identity.Legacy()
+	client.silences[legacy.ID] = *legacy
+
+	management, err := s.SyncSilence(ctx, newInstanceSilence("management"), "")
+	require.NoError(t, err)
+	assert.Equal(t, metrics.SyncOutcomeCreated, management.Outcome, "the legacy silence belongs to the default installation")
+
+	workload, err := s.SyncSilence(ctx, newInstanceSilence("workload"), "")
+	require.NoError(t, err)
+	assert.Equal(t, metrics.SyncOutcomeCreated, workload.Outcome, "the silence of the management instance is left alone")
+	assert.NotEqual(t, management.SilenceID, workload.SilenceID)
+
+	require.NoError(t, s.DeleteSilence(ctx, newInstanceSilence("workload").Comment, management.SilenceID, ""))
+	assert.NotContains(t, client.silences, workload.SilenceID)
+	assert.Contains(t, client.silences, management.SilenceID, "the ID of another instance's silence is not deleted")
+	assert.Contains(t, client.silences, legacy.ID)
+}
+
+func TestSilenceService_InstancesWithoutCache(t *testing.T) {
+	ctx := context.Background()
+	identity := alertmanager.Identity{Group: "observability.giantswarm.io", Kind: "Silence", Namespace: "ns", Name: "shared", UID: "1234"}
+	identity.Instance = "workload"
+
+	// The silence of the management instance has the identity of the workload instance,
+	// e.g. because it was copied by hand.
+	management := newTestSilence("shared")
+	management.ID = "management-id"
+	management.Comment = identity.String()
+	management.CreatedBy = alertmanager.Instance("management").CreatedBy()
+	management.Status = &alertmanager.Status{State: "active"}
+
+	var mu sync.Mutex
+	created := []alertmanager.Silence{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/silences":
+			assert.NoError(t, json.NewEncoder(w).Encode(append([]alertmanager.Silence{*management}, created...)))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/silence/"+management.ID:
+			assert.NoError(t, json.NewEncoder(w).Encode(management))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/silences":
+			var silence alertmanager.Silence
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&silence))
+			assert.NotEqual(t, management.ID, silence.ID, "the silence of the management instance is not updated")
+			silence.ID = fmt.Sprintf("created-%d", len(created))
+			created = append(created, silence)
+			assert.NoError(t, json.NewEncoder(w).Encode(map[string]string{"silenceID": silence.ID}))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	am, err := alertmanager.New(config.Config{Address: server.URL})
+	require.NoError(t, err)
+	s := NewSilenceService(am, 0)
+
+	for _, id := range []string{"", management.ID} {
+		silence := newTestSilence("shared")
+		silence.ID = id
+		silence.Comment = identity.String()
+		silence.CreatedBy = identity.Instance.CreatedBy()
+
+		result, err := s.SyncSilence(ctx, silence, "")
+		require.NoError(t, err)
+		assert.Equal(t, metrics.SyncOutcomeCreated, result.Outcome, "looked up with ID %q", id)
+		assert.NotEqual(t, management.ID, result.SilenceID)
+
+		mu.Lock()
+		created = nil
+		mu.Unlock()
+	}
+}
+
+func TestSilenceService_Targets(t *testing.T) {
+	s := NewSilenceService(newFakeClient(), time.Minute)
+	created := 0
+	s.newClient = func(config.Config) (alertmanager.Client, error) {
+		created++
+		return newFakeClient(), nil
+	}
+
+	first, err := s.Target("am", "uid-1/1", "", config.Config{})
+	require.NoError(t, err)
+	again, err := s.Target("am", "uid-1/1", "", config.Config{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, created, "the client is reused while the revision is unchanged")
+	assert.Same(t, first.client, again.client)
+
+	recreated, err := s.Target("am", "uid-2/1", "", config.Config{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, created, "a recreated AlertmanagerTarget gets a new client")
+	assert.NotSame(t, first.client, recreated.client)
+
+	s.RemoveTarget("am")
+	assert.NotContains(t, s.targets, "am")
+	_, err = s.Target("am", "uid-2/1", "", config.Config{})
+	require.NoError(t, err)
+	assert.Equal(t, 3, created, "a removed AlertmanagerTarget gets a new client")
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/giantswarm/silence-operator/pkg/alertmanager"
+	"github.com/giantswarm/silence-operator/pkg/config"
+)
+
+// Target is an Alertmanager a silence is synced to.
+type Target struct {
+	// Name is the name of the AlertmanagerTarget. It is empty for the default Alertmanager.
+	Name string
+	// Tenant is the tenant the silence is synced to.
+	Tenant string
+
+	client alertmanager.Client
+}
+
+type targetClient struct {
+	revision string
+	client   alertmanager.Client
+}
+
+func newAlertmanagerClient(cfg config.Config) (alertmanager.Client, error) {
+	return alertmanager.New(cfg)
+}
+
+// DefaultTarget returns the Alertmanager configured with --alertmanager-address.
+func (s *SilenceService) DefaultTarget(tenant string) Target {
+	return Target{Tenant: tenant, client: s.alertmanager}
+}
+
+// Target returns the AlertmanagerTarget called name. Its client is created on first use and
+// recreated whenever revision changes, so updated addresses and credentials are picked up.
+// The revision must change when the AlertmanagerTarget is deleted and created again.
+func (s *SilenceService) Target(name, revision, tenant string, cfg config.Config) (Target, error) {
+	s.targetsMu.Lock()
+	defer s.targetsMu.Unlock()
+
+	cached, ok := s.targets[name]
+	if !ok || cached.revision != revision {
+		client, err := s.newClient(cfg)
+		if err != nil {
+			return Target{}, errors.Wrapf(err, "failed to create client for AlertmanagerTarget %q", name)
+		}
+		if s.guard != nil {
+			client = s.guard.Wrap(name, client)
+		}
+		cached = targetClient{revision: revision, client: client}
+		s.targets[name] = cached
+		if s.cache != nil {
+			s.cache.invalidateTarget(name)
+		}
+	}
+
+	return Target{Name: name, Tenant: tenant, client: cached.client}, nil
+}
+
+// RemoveTarget drops the client, cached silences and circuit breaker of the
+// AlertmanagerTarget called name, once it was deleted.
+func (s *SilenceService) RemoveTarget(name string) {
+	s.targetsMu.Lock()
+	delete(s.targets, name)
+	s.targetsMu.Unlock()
+
+	if s.cache != nil {
+		s.cache.invalidateTarget(name)
+	}
+	if s.guard != nil {
+		s.guard.Remove(name)
+	}
+}